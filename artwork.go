@@ -0,0 +1,42 @@
+package soundcloud
+
+import "regexp"
+
+// ArtworkSize is a size artworks and avatars are served in.
+type ArtworkSize string
+
+const (
+	ArtworkMini     ArtworkSize = "mini"     // 16x16
+	ArtworkTiny     ArtworkSize = "tiny"     // 20x20
+	ArtworkSmall    ArtworkSize = "small"    // 32x32
+	ArtworkBadge    ArtworkSize = "badge"    // 47x47
+	ArtworkT67      ArtworkSize = "t67x67"   // 67x67
+	ArtworkLarge    ArtworkSize = "large"    // 100x100, returned by the api
+	ArtworkT300     ArtworkSize = "t300x300" // 300x300
+	ArtworkCrop     ArtworkSize = "crop"     // 400x400
+	ArtworkT500     ArtworkSize = "t500x500" // 500x500
+	ArtworkOriginal ArtworkSize = "original" // as uploaded
+)
+
+func (s ArtworkSize) String() string {
+	return string(s)
+}
+
+var artworkSizeRegexp = regexp.MustCompile(`-(mini|tiny|small|badge|t67x67|large|t300x300|crop|t500x500|original)(\.[a-z]+)$`)
+
+// ResizeArtworkURL rewrites an artwork or avatar url to point to size.
+// Urls which are not sized are returned unchanged.
+func ResizeArtworkURL(url string, size ArtworkSize) string {
+	return artworkSizeRegexp.ReplaceAllString(url, "-"+size.String()+"$2")
+}
+
+// Artwork returns the url of the track's artwork in size, falling back to the uploader's avatar.
+func (t Track) Artwork(size ArtworkSize) string {
+	if len(t.ArtworkURL) > 0 {
+		return ResizeArtworkURL(t.ArtworkURL, size)
+	}
+	if len(t.User.AvatarURL) > 0 {
+		return ResizeArtworkURL(t.User.AvatarURL, size)
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package soundcloud
+
+type ChartKind string
+
+const (
+	TOP      ChartKind = "top"
+	TRENDING ChartKind = "trending"
+)
+
+func (k ChartKind) String() string {
+	return string(k)
+}
+
+type ChartGenre string
+
+const (
+	GenreAllMusic             ChartGenre = "all-music"
+	GenreAllAudio             ChartGenre = "all-audio"
+	GenreAlternativeRock      ChartGenre = "alternativerock"
+	GenreAmbient              ChartGenre = "ambient"
+	GenreClassical            ChartGenre = "classical"
+	GenreCountry              ChartGenre = "country"
+	GenreDanceEDM             ChartGenre = "danceedm"
+	GenreDancehall            ChartGenre = "dancehall"
+	GenreDeepHouse            ChartGenre = "deephouse"
+	GenreDisco                ChartGenre = "disco"
+	GenreDrumBass             ChartGenre = "drumbass"
+	GenreDubstep              ChartGenre = "dubstep"
+	GenreElectronic           ChartGenre = "electronic"
+	GenreFolkSingerSongwriter ChartGenre = "folksingersongwriter"
+	GenreHipHopRap            ChartGenre = "hiphoprap"
+	GenreHouse                ChartGenre = "house"
+	GenreIndie                ChartGenre = "indie"
+	GenreJazzBlues            ChartGenre = "jazzblues"
+	GenreLatin                ChartGenre = "latin"
+	GenreMetal                ChartGenre = "metal"
+	GenrePiano                ChartGenre = "piano"
+	GenrePop                  ChartGenre = "pop"
+	GenreRnBSoul              ChartGenre = "rbsoul"
+	GenreReggae               ChartGenre = "reggae"
+	GenreReggaeton            ChartGenre = "reggaeton"
+	GenreRock                 ChartGenre = "rock"
+	GenreSoundtrack           ChartGenre = "soundtrack"
+	GenreTechno               ChartGenre = "techno"
+	GenreTrance               ChartGenre = "trance"
+	GenreTrap                 ChartGenre = "trap"
+	GenreTriphop              ChartGenre = "triphop"
+	GenreWorld                ChartGenre = "world"
+)
+
+func (g ChartGenre) String() string {
+	return string(g)
+}
+
+type ChartEntry struct {
+	// Position in the chart, starting at 1.
+	Position int     `json:"position"`
+	Score    float64 `json:"score"`
+	Track    Track   `json:"track"`
+}
+
+type chartEntryAPIResponse struct {
+	Score float64          `json:"score"`
+	Track trackAPIResponse `json:"track"`
+}
+
+func (r *chartEntryAPIResponse) toChartEntry(keepRaw bool) ChartEntry {
+	return ChartEntry{
+		Score: r.Score,
+		Track: r.Track.toTrack(keepRaw),
+	}
+}
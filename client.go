@@ -1,19 +1,30 @@
 package soundcloud
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 // client options.
 type clientOptions struct {
-	httpClient *http.Client
-	clientId   string
+	httpClient    *http.Client
+	clientId      string
+	clientIdStore ClientIDStore
+	clientIdTTL   time.Duration
+	lazy          bool
+	rawResponses  bool
 }
 
 type ClientOption func(o *clientOptions)
 
 func defaultClientOptions() *clientOptions {
 	return &clientOptions{
-		httpClient: &http.Client{},
-		clientId:   "",
+		httpClient:    &http.Client{},
+		clientId:      "",
+		clientIdStore: nil,
+		clientIdTTL:   24 * time.Hour,
+		lazy:          false,
+		rawResponses:  false,
 	}
 }
 
@@ -28,3 +39,32 @@ func WithClientID(clientId string) ClientOption {
 		o.clientId = clientId
 	}
 }
+
+// WithClientIDStore caches scraped client ids in store.
+// A stored client id is reused until it is older than the max age or rejected by the api.
+func WithClientIDStore(store ClientIDStore) ClientOption {
+	return func(o *clientOptions) {
+		o.clientIdStore = store
+	}
+}
+
+// WithClientIDMaxAge sets how long a stored client id is reused before it is scraped again.
+func WithClientIDMaxAge(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.clientIdTTL = d
+	}
+}
+
+// WithLazyClientID defers acquiring the client id until the first api call that needs it.
+func WithLazyClientID() ClientOption {
+	return func(o *clientOptions) {
+		o.lazy = true
+	}
+}
+
+// WithRawResponses keeps the api payloads of tracks, users, playlists and collections in their Raw field.
+func WithRawResponses() ClientOption {
+	return func(o *clientOptions) {
+		o.rawResponses = true
+	}
+}
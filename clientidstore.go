@@ -0,0 +1,106 @@
+package soundcloud
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// ClientIDStore persists a scraped client id so it can be reused across clients and processes.
+type ClientIDStore interface {
+	// Load returns the stored client id and the time it was saved.
+	// An empty client id is returned when nothing is stored.
+	Load() (clientId string, savedAt time.Time, err error)
+
+	// Save stores the client id along with the time it was obtained.
+	Save(clientId string, savedAt time.Time) error
+}
+
+// FileClientIDStore is a ClientIDStore backed by a JSON file.
+type FileClientIDStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+type fileClientIDStoreData struct {
+	ClientID string    `json:"client_id"`
+	SavedAt  time.Time `json:"saved_at"`
+}
+
+// NewFileClientIDStore returns a store that keeps the client id in the file at path.
+func NewFileClientIDStore(path string) *FileClientIDStore {
+	return &FileClientIDStore{
+		path: path,
+	}
+}
+
+// DefaultClientIDStorePath returns a path to cache the client id in the user's cache directory.
+func DefaultClientIDStorePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "soundcloud", "client_id.json"), nil
+}
+
+// Load
+func (s *FileClientIDStore) Load() (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", time.Time{}, nil
+	}
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	data := new(fileClientIDStoreData)
+	err = json.Unmarshal(b, data)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return data.ClientID, data.SavedAt, nil
+}
+
+// Save
+func (s *FileClientIDStore) Save(clientId string, savedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := json.Marshal(fileClientIDStoreData{
+		ClientID: clientId,
+		SavedAt:  savedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so readers never see a partial file.
+	f, err := os.CreateTemp(dir, ".client_id-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path)
+}
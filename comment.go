@@ -0,0 +1,90 @@
+package soundcloud
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Comment struct {
+	ID   int64  `json:"id"`
+	Body string `json:"body"`
+	// Timestamp is the position in the track the comment was made at.
+	Timestamp time.Duration `json:"timestamp"`
+	CreatedAt time.Time     `json:"created_at"`
+	User      User          `json:"user"`
+	Kind      string        `json:"kind"`
+}
+
+// MarshalJSON encodes the timestamp in milliseconds.
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type alias Comment
+	return json.Marshal(struct {
+		alias
+		Timestamp int64 `json:"timestamp"`
+	}{alias(c), c.Timestamp.Milliseconds()})
+}
+
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type alias Comment
+	v := struct {
+		*alias
+		Timestamp int64 `json:"timestamp"`
+	}{alias: (*alias)(c)}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	c.Timestamp = time.Duration(v.Timestamp) * time.Millisecond
+	return nil
+}
+
+type commentAPIResponse struct {
+	Body      string          `json:"body"`
+	CreatedAt time.Time       `json:"created_at"`
+	ID        int64           `json:"id"`
+	Kind      string          `json:"kind"`
+	Timestamp int64           `json:"timestamp"`
+	TrackID   int64           `json:"track_id"`
+	UserID    int64           `json:"user_id"`
+	User      userAPIResponse `json:"user"`
+}
+
+func (r *commentAPIResponse) toComment(keepRaw bool) Comment {
+	return Comment{
+		ID:        r.ID,
+		Body:      r.Body,
+		Timestamp: time.Duration(r.Timestamp) * time.Millisecond,
+		CreatedAt: r.CreatedAt,
+		User:      r.User.toUser(keepRaw),
+		Kind:      r.Kind,
+	}
+}
+
+// CommentOrder is the order track comments are returned in.
+type CommentOrder string
+
+const (
+	CommentOrderNewest    CommentOrder = "newest"
+	CommentOrderOldest    CommentOrder = "oldest"
+	CommentOrderTimestamp CommentOrder = "timestamp"
+)
+
+func (o CommentOrder) String() string {
+	return string(o)
+}
+
+// CommentOption is a SearchOption only used by GetTrackComments.
+type CommentOption = SearchOption
+
+// WithThreaded groups replies right after the comment they reply to.
+func WithThreaded(threaded bool) CommentOption {
+	return func(o *searchOptions) {
+		o.threaded = threaded
+	}
+}
+
+func WithCommentOrder(order CommentOrder) CommentOption {
+	return func(o *searchOptions) {
+		o.commentOrder = order
+	}
+}
@@ -0,0 +1,100 @@
+package soundcloud
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrGeoBlocked   = errors.New("geo blocked")
+	ErrServerError  = errors.New("server error")
+
+	ErrUnsupportedKind = errors.New("unsupported kind")
+	ErrNoWaveform      = errors.New("track has no waveform")
+	ErrNoArtwork       = errors.New("track has no artwork")
+
+	ErrNotCreativeCommons = errors.New("track is not creative commons licensed")
+)
+
+// maximum number of response body bytes kept on an APIError.
+const maxErrorBodySize = 1024
+
+// APIError is returned when Soundcloud responds with an unexpected status code.
+//
+// It can be matched against ErrNotFound, ErrUnauthorized, ErrForbidden,
+// ErrRateLimited, ErrGeoBlocked and ErrServerError using errors.Is.
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+	RequestID  string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d (%s)", e.StatusCode, e.Endpoint)
+}
+
+func (e *APIError) Unwrap() error {
+	switch {
+	case e.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusUnavailableForLegalReasons:
+		return ErrGeoBlocked
+	case e.StatusCode == http.StatusForbidden && strings.Contains(strings.ToLower(e.Body), "geo"):
+		return ErrGeoBlocked
+	case e.StatusCode == http.StatusForbidden:
+		return ErrForbidden
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case e.StatusCode >= http.StatusInternalServerError:
+		return ErrServerError
+	}
+	return nil
+}
+
+// newAPIError builds an APIError from resp, consuming at most maxErrorBodySize bytes of its body.
+func newAPIError(resp *http.Response) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Request != nil && resp.Request.URL != nil {
+		e.Endpoint = resp.Request.URL.Path
+	}
+
+	for _, h := range []string{"X-Request-Id", "X-Amz-Cf-Id"} {
+		if v := resp.Header.Get(h); len(v) > 0 {
+			e.RequestID = v
+			break
+		}
+	}
+
+	if resp.Body != nil {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		e.Body = string(b)
+	}
+
+	return e
+}
+
+// UnsupportedKindError is returned when the api responds with a resource kind the client cannot handle.
+type UnsupportedKindError struct {
+	Kind string
+}
+
+func (e *UnsupportedKindError) Error() string {
+	return fmt.Sprintf("unsupported kind: %q", e.Kind)
+}
+
+func (e *UnsupportedKindError) Unwrap() error {
+	return ErrUnsupportedKind
+}
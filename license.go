@@ -0,0 +1,132 @@
+package soundcloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// License is the license a track is published under.
+type License string
+
+const (
+	LicenseAllRightsReserved License = "all-rights-reserved"
+	LicenseNoRightsReserved  License = "no-rights-reserved" // CC0
+	LicenseCCBY              License = "cc-by"
+	LicenseCCBYSA            License = "cc-by-sa"
+	LicenseCCBYND            License = "cc-by-nd"
+	LicenseCCBYNC            License = "cc-by-nc"
+	LicenseCCBYNCSA          License = "cc-by-nc-sa"
+	LicenseCCBYNCND          License = "cc-by-nc-nd"
+)
+
+// version of the Creative Commons licenses offered by Soundcloud.
+const creativeCommonsVersion = "3.0"
+
+func (l License) String() string {
+	return string(l)
+}
+
+// IsCreativeCommons reports whether l is a Creative Commons license, including CC0.
+func (l License) IsCreativeCommons() bool {
+	return l == LicenseNoRightsReserved || strings.HasPrefix(string(l), "cc-")
+}
+
+// Name returns the short name of a Creative Commons license, such as "CC BY-NC 3.0".
+func (l License) Name() string {
+	switch {
+	case l == LicenseNoRightsReserved:
+		return "CC0 1.0"
+	case l.IsCreativeCommons():
+		return fmt.Sprintf("CC %s %s", strings.ToUpper(strings.TrimPrefix(string(l), "cc-")), creativeCommonsVersion)
+	}
+	return ""
+}
+
+// URL returns the deed of a Creative Commons license.
+func (l License) URL() string {
+	switch {
+	case l == LicenseNoRightsReserved:
+		return "https://creativecommons.org/publicdomain/zero/1.0/"
+	case l.IsCreativeCommons():
+		return fmt.Sprintf("https://creativecommons.org/licenses/%s/%s/", strings.TrimPrefix(string(l), "cc-"), creativeCommonsVersion)
+	}
+	return ""
+}
+
+// Attribution returns the credit line to use when reusing a Creative Commons licensed track,
+// naming its title, uploader, source and license.
+func (t Track) Attribution() (string, error) {
+	if !t.License.IsCreativeCommons() {
+		return "", fmt.Errorf("%w: %s", ErrNotCreativeCommons, t.License)
+	}
+
+	credit := fmt.Sprintf("\"%s\" by %s", t.Title, t.User.Username)
+	if len(t.PermalinkURL) > 0 {
+		credit = fmt.Sprintf("%s (%s)", credit, t.PermalinkURL)
+	}
+
+	if t.License == LicenseNoRightsReserved {
+		return fmt.Sprintf("%s is marked with %s (%s)", credit, t.License.Name(), t.License.URL()), nil
+	}
+	return fmt.Sprintf("%s is licensed under %s (%s)", credit, t.License.Name(), t.License.URL()), nil
+}
+
+type PublisherMetadata struct {
+	ID              int64  `json:"id"`
+	URN             URN    `json:"urn"`
+	Artist          string `json:"artist"`
+	AlbumTitle      string `json:"album_title"`
+	ReleaseTitle    string `json:"release_title"`
+	ISRC            string `json:"isrc"`
+	UPCOrEAN        string `json:"upc_or_ean"`
+	Publisher       string `json:"publisher"`
+	WriterComposer  string `json:"writer_composer"`
+	PLine           string `json:"p_line"`
+	PLineForDisplay string `json:"p_line_for_display"`
+	CLine           string `json:"c_line"`
+	CLineForDisplay string `json:"c_line_for_display"`
+	Explicit        bool   `json:"explicit"`
+	ContainsMusic   bool   `json:"contains_music"`
+}
+
+type publisherMetadataAPIResponse struct {
+	ID              int64  `json:"id"`
+	Urn             string `json:"urn"`
+	Artist          string `json:"artist"`
+	AlbumTitle      string `json:"album_title"`
+	ContainsMusic   bool   `json:"contains_music"`
+	UpcOrEan        string `json:"upc_or_ean"`
+	Isrc            string `json:"isrc"`
+	Explicit        bool   `json:"explicit"`
+	PLine           string `json:"p_line"`
+	PLineForDisplay string `json:"p_line_for_display"`
+	CLine           string `json:"c_line"`
+	CLineForDisplay string `json:"c_line_for_display"`
+	WriterComposer  string `json:"writer_composer"`
+	ReleaseTitle    string `json:"release_title"`
+	Publisher       string `json:"publisher"`
+}
+
+func (r *publisherMetadataAPIResponse) toPublisherMetadata() *PublisherMetadata {
+	if r == nil {
+		return nil
+	}
+
+	return &PublisherMetadata{
+		ID:              r.ID,
+		URN:             parseAPIURN(r.Urn),
+		Artist:          r.Artist,
+		AlbumTitle:      r.AlbumTitle,
+		ReleaseTitle:    r.ReleaseTitle,
+		ISRC:            r.Isrc,
+		UPCOrEAN:        r.UpcOrEan,
+		Publisher:       r.Publisher,
+		WriterComposer:  r.WriterComposer,
+		PLine:           r.PLine,
+		PLineForDisplay: r.PLineForDisplay,
+		CLine:           r.CLine,
+		CLineForDisplay: r.CLineForDisplay,
+		Explicit:        r.Explicit,
+		ContainsMusic:   r.ContainsMusic,
+	}
+}
@@ -0,0 +1,39 @@
+package soundcloud
+
+import "time"
+
+type TrackLike struct {
+	CreatedAt time.Time `json:"created_at"`
+	Track     Track     `json:"track"`
+}
+
+type PlaylistLike struct {
+	CreatedAt time.Time `json:"created_at"`
+	Playlist  Playlist  `json:"playlist"`
+}
+
+type trackLikeAPIResponse struct {
+	CreatedAt time.Time        `json:"created_at"`
+	Kind      string           `json:"kind"`
+	Track     trackAPIResponse `json:"track"`
+}
+
+func (r *trackLikeAPIResponse) toTrackLike(keepRaw bool) TrackLike {
+	return TrackLike{
+		CreatedAt: r.CreatedAt,
+		Track:     r.Track.toTrack(keepRaw),
+	}
+}
+
+type playlistLikeAPIResponse struct {
+	CreatedAt time.Time           `json:"created_at"`
+	Kind      string              `json:"kind"`
+	Playlist  playlistAPIResponse `json:"playlist"`
+}
+
+func (r *playlistLikeAPIResponse) toPlaylistLike(keepRaw bool) PlaylistLike {
+	return PlaylistLike{
+		CreatedAt: r.CreatedAt,
+		Playlist:  r.Playlist.toPlaylist(keepRaw),
+	}
+}
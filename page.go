@@ -0,0 +1,195 @@
+package soundcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	liburl "net/url"
+	"strings"
+)
+
+var (
+	ErrNoNextPage = errors.New("no next page")
+)
+
+// Page is a single page of a paginated collection.
+type Page[T any] struct {
+	Items    []T
+	NextHref string
+	// Raw is the api payload of the page, see WithRawResponses.
+	Raw json.RawMessage
+
+	// fetches the page at NextHref.
+	next func(ctx context.Context, href string) (Page[T], error)
+}
+
+// HasNext reports whether there is a page after this one.
+func (p Page[T]) HasNext() bool {
+	return len(p.NextHref) > 0 && p.next != nil
+}
+
+// Next fetches the page after this one by following NextHref.
+func (p Page[T]) Next(ctx context.Context) (Page[T], error) {
+	if !p.HasNext() {
+		return Page[T]{}, ErrNoNextPage
+	}
+	return p.next(ctx, p.NextHref)
+}
+
+type collectionAPIResponse[T any] struct {
+	Collection   []T    `json:"collection"`
+	NextHref     string `json:"next_href"`
+	TotalResults int    `json:"total_results"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *collectionAPIResponse[T]) UnmarshalJSON(data []byte) error {
+	type alias collectionAPIResponse[T]
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
+}
+
+func toPage[R, T any](r *collectionAPIResponse[R], keepRaw bool, convert func(*R, bool) T) Page[T] {
+	items := make([]T, 0)
+	for i := range r.Collection {
+		items = append(items, convert(&r.Collection[i], keepRaw))
+	}
+
+	return Page[T]{
+		Items:    items,
+		NextHref: r.NextHref,
+		Raw:      rawPayload(keepRaw, r.Raw),
+	}
+}
+
+// parseHref splits an api url, such as next_href, into its path and params.
+func parseHref(href string) (string, map[string]string, error) {
+	u, err := liburl.Parse(href)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !strings.HasPrefix(href, apiURL) {
+		return "", nil, fmt.Errorf("unexpected href: %s", u.Redacted())
+	}
+
+	params := make(map[string]string)
+	for k, v := range u.Query() {
+		if k != "client_id" && len(v) > 0 {
+			params[k] = v[0]
+		}
+	}
+
+	return strings.TrimPrefix(u.Path, "/"), params, nil
+}
+
+type paginatorOptions struct {
+	maxItems int
+}
+
+type PaginatorOption func(o *paginatorOptions)
+
+func defaultPaginatorOptions() *paginatorOptions {
+	return &paginatorOptions{
+		maxItems: 0,
+	}
+}
+
+// WithMaxItems stops the paginator after n items. Zero means no limit.
+func WithMaxItems(n int) PaginatorOption {
+	return func(o *paginatorOptions) {
+		o.maxItems = n
+	}
+}
+
+// Paginator walks a collection page by page, starting with the items of the first page.
+type Paginator[T any] struct {
+	page     Page[T]
+	started  bool
+	done     bool
+	count    int
+	maxItems int
+}
+
+// NewPaginator returns a paginator starting at first.
+func NewPaginator[T any](first Page[T], opts ...PaginatorOption) *Paginator[T] {
+	options := defaultPaginatorOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Paginator[T]{
+		page:     first,
+		maxItems: options.maxItems,
+	}
+}
+
+// HasNext reports whether Next can return more items.
+func (p *Paginator[T]) HasNext() bool {
+	if p.done || (p.maxItems > 0 && p.count >= p.maxItems) {
+		return false
+	}
+	return !p.started || p.page.HasNext()
+}
+
+// Next returns the items of the next page.
+func (p *Paginator[T]) Next(ctx context.Context) ([]T, error) {
+	if !p.HasNext() {
+		return nil, ErrNoNextPage
+	}
+
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	items := p.page.Items
+	if p.started {
+		page, err := p.page.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.page = page
+		items = page.Items
+
+		// guard against collections that keep linking to empty pages.
+		if len(items) == 0 {
+			p.done = true
+		}
+	}
+	p.started = true
+
+	if p.maxItems > 0 && len(items) > p.maxItems-p.count {
+		items = items[:p.maxItems-p.count]
+	}
+	p.count += len(items)
+
+	return items, nil
+}
+
+// All returns an iterator over the remaining items, stopping at the first error.
+func (p *Paginator[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasNext() {
+			items, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package soundcloud
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type SetType string
+
+const (
+	SetTypePlaylist    SetType = "playlist"
+	SetTypeAlbum       SetType = "album"
+	SetTypeEP          SetType = "ep"
+	SetTypeSingle      SetType = "single"
+	SetTypeCompilation SetType = "compilation"
+)
+
+func (s SetType) String() string {
+	return string(s)
+}
+
+type Playlist struct {
+	ID           int64         `json:"id"`
+	URN          URN           `json:"urn"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description"`
+	SetType      SetType       `json:"set_type"`
+	ArtworkURL   string        `json:"artwork_url"`
+	Duration     time.Duration `json:"duration"`
+	Genre        string        `json:"genre"`
+	TrackCount   int           `json:"track_count"`
+	LikesCount   int           `json:"likes_count"`
+	RepostsCount int           `json:"reposts_count"`
+	Public       bool          `json:"public"`
+	Sharing      string        `json:"sharing"`
+	Permalink    string        `json:"permalink"`
+	PermalinkURL string        `json:"permalink_url"`
+	ReleaseDate  time.Time     `json:"release_date"`
+	CreatedAt    time.Time     `json:"created_at"`
+	Tracks       []Track       `json:"tracks"`
+	User         User          `json:"user"`
+	Kind         string        `json:"kind"`
+	// MissingTrackIDs are the ids of tracks in the playlist that are not accessible, and so not in Tracks.
+	MissingTrackIDs []int64 `json:"missing_track_ids,omitempty"`
+	// Raw is the api payload of the playlist, see WithRawResponses.
+	Raw json.RawMessage `json:"raw,omitempty"`
+}
+
+// MarshalJSON encodes the duration in milliseconds.
+func (p Playlist) MarshalJSON() ([]byte, error) {
+	type alias Playlist
+	return json.Marshal(struct {
+		alias
+		Duration int64 `json:"duration"`
+	}{alias(p), p.Duration.Milliseconds()})
+}
+
+func (p *Playlist) UnmarshalJSON(data []byte) error {
+	type alias Playlist
+	v := struct {
+		*alias
+		Duration int64 `json:"duration"`
+	}{alias: (*alias)(p)}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	p.Duration = time.Duration(v.Duration) * time.Millisecond
+	return nil
+}
+
+type playlistAPIResponse struct {
+	ArtworkURL   string             `json:"artwork_url"`
+	CreatedAt    time.Time          `json:"created_at"`
+	Description  string             `json:"description"`
+	Duration     int64              `json:"duration"`
+	Genre        string             `json:"genre"`
+	ID           int64              `json:"id"`
+	IsAlbum      bool               `json:"is_album"`
+	Kind         string             `json:"kind"`
+	LikesCount   int                `json:"likes_count"`
+	Permalink    string             `json:"permalink"`
+	PermalinkURL string             `json:"permalink_url"`
+	Public       bool               `json:"public"`
+	PublishedAt  *time.Time         `json:"published_at"`
+	ReleaseDate  string             `json:"release_date"`
+	RepostsCount int                `json:"reposts_count"`
+	SetType      string             `json:"set_type"`
+	Sharing      string             `json:"sharing"`
+	Title        string             `json:"title"`
+	TrackCount   int                `json:"track_count"`
+	Tracks       []trackAPIResponse `json:"tracks"`
+	URI          string             `json:"uri"`
+	Urn          string             `json:"urn"`
+	UserID       int64              `json:"user_id"`
+	User         userAPIResponse    `json:"user"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *playlistAPIResponse) UnmarshalJSON(data []byte) error {
+	type alias playlistAPIResponse
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
+}
+
+// toPlaylist converts the response, leaving out tracks that were only returned as stubs.
+func (r *playlistAPIResponse) toPlaylist(keepRaw bool) Playlist {
+	tracks := make([]Track, 0)
+	for _, t := range r.Tracks {
+		if !t.isStub() {
+			tracks = append(tracks, t.toTrack(keepRaw))
+		}
+	}
+
+	setType := SetType(r.SetType)
+	if len(setType) == 0 {
+		setType = SetTypePlaylist
+		if r.IsAlbum {
+			setType = SetTypeAlbum
+		}
+	}
+
+	return Playlist{
+		ID:           r.ID,
+		URN:          parseAPIURN(r.Urn),
+		Title:        r.Title,
+		Description:  r.Description,
+		SetType:      setType,
+		ArtworkURL:   r.ArtworkURL,
+		Duration:     time.Duration(r.Duration) * time.Millisecond,
+		Genre:        r.Genre,
+		TrackCount:   r.TrackCount,
+		LikesCount:   r.LikesCount,
+		RepostsCount: r.RepostsCount,
+		Public:       r.Public,
+		Sharing:      r.Sharing,
+		Permalink:    r.Permalink,
+		PermalinkURL: r.PermalinkURL,
+		ReleaseDate:  parseReleaseDate(r.ReleaseDate),
+		CreatedAt:    r.CreatedAt,
+		Tracks:       tracks,
+		User:         r.User.toUser(keepRaw),
+		Kind:         r.Kind,
+		Raw:          rawPayload(keepRaw, r.Raw),
+	}
+}
+
+// stubTrackIds returns the ids of tracks that were returned without their metadata.
+func (r *playlistAPIResponse) stubTrackIds() []int64 {
+	ids := make([]int64, 0)
+	for _, t := range r.Tracks {
+		if t.isStub() {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids
+}
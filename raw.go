@@ -0,0 +1,33 @@
+package soundcloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrNoRawResponse = errors.New("no raw response, see WithRawResponses")
+)
+
+// DecodeRaw decodes a raw api payload, such as Track.Raw, into v.
+// It can be used to read fields the client does not expose yet.
+func DecodeRaw(raw json.RawMessage, v any) error {
+	if len(raw) == 0 {
+		return ErrNoRawResponse
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// rawPayload returns raw if keepRaw is set, and nil otherwise.
+func rawPayload(keepRaw bool, raw json.RawMessage) json.RawMessage {
+	if !keepRaw {
+		return nil
+	}
+	return raw
+}
+
+// cloneRaw copies data, which json.Unmarshaler implementations must not retain.
+func cloneRaw(data []byte) json.RawMessage {
+	return json.RawMessage(bytes.Clone(data))
+}
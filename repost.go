@@ -0,0 +1,38 @@
+package soundcloud
+
+import "time"
+
+// Repost is a reposted track or playlist. Only the field matching Kind is set.
+type Repost struct {
+	CreatedAt time.Time    `json:"created_at"`
+	Kind      ResourceKind `json:"kind"`
+	Track     *Track       `json:"track,omitempty"`
+	Playlist  *Playlist    `json:"playlist,omitempty"`
+}
+
+type repostAPIResponse struct {
+	CreatedAt time.Time            `json:"created_at"`
+	Type      string               `json:"type"`
+	Track     *trackAPIResponse    `json:"track"`
+	Playlist  *playlistAPIResponse `json:"playlist"`
+	User      userAPIResponse      `json:"user"`
+}
+
+func (r *repostAPIResponse) toRepost(keepRaw bool) Repost {
+	repost := Repost{
+		CreatedAt: r.CreatedAt,
+	}
+
+	switch {
+	case r.Track != nil:
+		t := r.Track.toTrack(keepRaw)
+		repost.Kind = TRACK
+		repost.Track = &t
+	case r.Playlist != nil:
+		p := r.Playlist.toPlaylist(keepRaw)
+		repost.Kind = PLAYLIST
+		repost.Playlist = &p
+	}
+
+	return repost
+}
@@ -0,0 +1,81 @@
+package soundcloud
+
+import "encoding/json"
+
+type ResourceKind string
+
+const (
+	TRACK    ResourceKind = "track"
+	PLAYLIST ResourceKind = "playlist"
+	USER     ResourceKind = "user"
+)
+
+func (k ResourceKind) String() string {
+	return string(k)
+}
+
+// Resource is a track, playlist or user. Only the field matching Kind is set.
+type Resource struct {
+	Kind     ResourceKind `json:"kind"`
+	Track    *Track       `json:"track,omitempty"`
+	Playlist *Playlist    `json:"playlist,omitempty"`
+	User     *User        `json:"user,omitempty"`
+}
+
+// resourceAPIResponse decodes a track, playlist or user based on its kind field.
+// None of them are set for other kinds.
+type resourceAPIResponse struct {
+	Kind     string
+	Track    *trackAPIResponse
+	Playlist *playlistAPIResponse
+	User     *userAPIResponse
+}
+
+func (r *resourceAPIResponse) UnmarshalJSON(data []byte) error {
+	v := &struct {
+		Kind string `json:"kind"`
+	}{}
+	err := json.Unmarshal(data, v)
+	if err != nil {
+		return err
+	}
+
+	*r = resourceAPIResponse{Kind: v.Kind}
+	switch ResourceKind(v.Kind) {
+	case TRACK:
+		r.Track = new(trackAPIResponse)
+		return json.Unmarshal(data, r.Track)
+	case PLAYLIST:
+		r.Playlist = new(playlistAPIResponse)
+		return json.Unmarshal(data, r.Playlist)
+	case USER:
+		r.User = new(userAPIResponse)
+		return json.Unmarshal(data, r.User)
+	}
+
+	return nil
+}
+
+func (r *resourceAPIResponse) isSupported() bool {
+	return r.Track != nil || r.Playlist != nil || r.User != nil
+}
+
+func (r *resourceAPIResponse) toResource(keepRaw bool) Resource {
+	res := Resource{
+		Kind: ResourceKind(r.Kind),
+	}
+
+	switch {
+	case r.Track != nil:
+		t := r.Track.toTrack(keepRaw)
+		res.Track = &t
+	case r.Playlist != nil:
+		p := r.Playlist.toPlaylist(keepRaw)
+		res.Playlist = &p
+	case r.User != nil:
+		u := r.User.toUser(keepRaw)
+		res.User = &u
+	}
+
+	return res
+}
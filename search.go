@@ -1,32 +1,138 @@
 package soundcloud
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LicenseFilter restricts track search results by what the license allows.
+type LicenseFilter string
+
+const (
+	LicenseToListen             LicenseFilter = "to_listen"
+	LicenseToShare              LicenseFilter = "to_share"
+	LicenseToUseCommercially    LicenseFilter = "to_use_commercially"
+	LicenseToModifyCommercially LicenseFilter = "to_modify_commercially"
+)
+
+func (l LicenseFilter) String() string {
+	return string(l)
+}
+
+// Facet is a field track search results can be counted by.
+type Facet string
+
+const (
+	GenreFacet Facet = "genre"
+)
+
+func (f Facet) String() string {
+	return string(f)
+}
+
+// layout of dates in search filters.
+const searchDateLayout = "2006-01-02 15:04:05"
 
 type searchOptions struct {
-	q      string
 	limit  int
 	offset int
+
+	// track search filters, only sent when set.
+	genre        string
+	tags         []string
+	durationFrom time.Duration
+	durationTo   time.Duration
+	createdFrom  time.Time
+	createdTo    time.Time
+	license      LicenseFilter
+	bpmFrom      int
+	bpmTo        int
+	facet        Facet
+
+	// comment options, only sent by GetTrackComments.
+	threaded     bool
+	commentOrder CommentOrder
 }
 
 type SearchOption func(o *searchOptions)
 
 func defaultSearchOptions() *searchOptions {
 	return &searchOptions{
-		q:      "",
-		limit:  20,
-		offset: 0,
+		limit:        20,
+		offset:       0,
+		commentOrder: CommentOrderNewest,
 	}
 }
 
 func (o *searchOptions) build() map[string]string {
 	p := make(map[string]string)
-	p["q"] = o.q
 	p["limit"] = strconv.Itoa(o.limit)
 	p["offset"] = strconv.Itoa(o.offset)
 
 	return p
 }
 
+// buildTracks returns the params for track search, which is the only one accepting filters.
+func (o *searchOptions) buildTracks() map[string]string {
+	p := o.build()
+	if len(o.genre) > 0 {
+		p["filter.genre"] = o.genre
+	}
+	if len(o.tags) > 0 {
+		p["filter.tags"] = strings.Join(o.tags, ",")
+	}
+	if o.durationFrom > 0 {
+		p["filter.duration[from]"] = strconv.FormatInt(o.durationFrom.Milliseconds(), 10)
+	}
+	if o.durationTo > 0 {
+		p["filter.duration[to]"] = strconv.FormatInt(o.durationTo.Milliseconds(), 10)
+	}
+	if !o.createdFrom.IsZero() {
+		p["filter.created_at[from]"] = o.createdFrom.UTC().Format(searchDateLayout)
+	}
+	if !o.createdTo.IsZero() {
+		p["filter.created_at[to]"] = o.createdTo.UTC().Format(searchDateLayout)
+	}
+	if len(o.license) > 0 {
+		p["filter.license"] = o.license.String()
+	}
+	if o.bpmFrom > 0 {
+		p["filter.bpm[from]"] = strconv.Itoa(o.bpmFrom)
+	}
+	if o.bpmTo > 0 {
+		p["filter.bpm[to]"] = strconv.Itoa(o.bpmTo)
+	}
+	if len(o.facet) > 0 {
+		p["facet"] = o.facet.String()
+	}
+
+	return p
+}
+
+// buildPage returns the params for listing endpoints, which only page through results.
+func (o *searchOptions) buildPage() map[string]string {
+	p := make(map[string]string)
+	p["limit"] = strconv.Itoa(o.limit)
+	p["offset"] = strconv.Itoa(o.offset)
+	p["linked_partitioning"] = "1"
+
+	return p
+}
+
+// buildComments returns the params for track comments, which page like listings.
+func (o *searchOptions) buildComments() map[string]string {
+	p := o.buildPage()
+	p["sort"] = o.commentOrder.String()
+	p["threaded"] = "0"
+	if o.threaded {
+		p["threaded"] = "1"
+	}
+	p["filter_replies"] = "0"
+
+	return p
+}
+
 func WithLimit(limit int) SearchOption {
 	return func(o *searchOptions) {
 		o.limit = limit
@@ -38,3 +144,56 @@ func WithOffset(offset int) SearchOption {
 		o.offset = offset
 	}
 }
+
+// WithGenre only returns tracks of genre.
+// Like the other filters below, it only applies to SearchTracks.
+func WithGenre(genre string) SearchOption {
+	return func(o *searchOptions) {
+		o.genre = genre
+	}
+}
+
+// WithTags only returns tracks tagged with tags.
+func WithTags(tags ...string) SearchOption {
+	return func(o *searchOptions) {
+		o.tags = tags
+	}
+}
+
+// WithDurationRange only returns tracks lasting between from and to. A zero bound is ignored.
+func WithDurationRange(from, to time.Duration) SearchOption {
+	return func(o *searchOptions) {
+		o.durationFrom = from
+		o.durationTo = to
+	}
+}
+
+// WithCreatedAtRange only returns tracks uploaded between from and to. A zero bound is ignored.
+func WithCreatedAtRange(from, to time.Time) SearchOption {
+	return func(o *searchOptions) {
+		o.createdFrom = from
+		o.createdTo = to
+	}
+}
+
+// WithLicense only returns tracks whose license allows l.
+func WithLicense(l LicenseFilter) SearchOption {
+	return func(o *searchOptions) {
+		o.license = l
+	}
+}
+
+// WithBPMRange only returns tracks with a tempo between from and to. A zero bound is ignored.
+func WithBPMRange(from, to int) SearchOption {
+	return func(o *searchOptions) {
+		o.bpmFrom = from
+		o.bpmTo = to
+	}
+}
+
+// WithFacet counts the track results by f, see SearchTracksResults.Facets.
+func WithFacet(f Facet) SearchOption {
+	return func(o *searchOptions) {
+		o.facet = f
+	}
+}
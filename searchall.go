@@ -0,0 +1,84 @@
+package soundcloud
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// modelFacet counts the results of the search endpoint by kind.
+const modelFacet Facet = "model"
+
+type SearchAllResults struct {
+	Total int
+	// Results in the order returned by the api, leaving out kinds the client does not handle.
+	Results []Resource
+	// Totals is the number of results of each kind across all pages.
+	Totals   map[ResourceKind]int
+	NextHref string
+	// Raw is the api payload of the results, see WithRawResponses.
+	Raw json.RawMessage
+
+	next func(ctx context.Context, href string) (Page[Resource], error)
+}
+
+// Page returns the results as a page, which can be passed to NewPaginator.
+func (r SearchAllResults) Page() Page[Resource] {
+	return Page[Resource]{
+		Items:    r.Results,
+		NextHref: r.NextHref,
+		next:     r.next,
+	}
+}
+
+type searchAllAPIResponse struct {
+	Collection   []resourceAPIResponse `json:"collection"`
+	NextHref     string                `json:"next_href"`
+	TotalResults int                   `json:"total_results"`
+	Facets       []struct {
+		Name   string `json:"name"`
+		Facets []struct {
+			Filter string `json:"filter"`
+			Value  string `json:"value"`
+			Count  int    `json:"count"`
+		} `json:"facets"`
+	} `json:"facets"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *searchAllAPIResponse) UnmarshalJSON(data []byte) error {
+	type alias searchAllAPIResponse
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
+}
+
+func (r *searchAllAPIResponse) toResults(keepRaw bool) SearchAllResults {
+	results := make([]Resource, 0)
+	for _, res := range r.Collection {
+		if res.isSupported() {
+			results = append(results, res.toResource(keepRaw))
+		}
+	}
+
+	totals := make(map[ResourceKind]int)
+	for _, f := range r.Facets {
+		if Facet(f.Name) != modelFacet {
+			continue
+		}
+		for _, v := range f.Facets {
+			totals[ResourceKind(v.Value)] = v.Count
+		}
+	}
+
+	return SearchAllResults{
+		Total:    r.TotalResults,
+		Results:  results,
+		Totals:   totals,
+		NextHref: r.NextHref,
+		Raw:      rawPayload(keepRaw, r.Raw),
+	}
+}
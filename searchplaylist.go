@@ -0,0 +1,25 @@
+package soundcloud
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type SearchPlaylistsResults struct {
+	Total     int
+	Playlists []Playlist
+	NextHref  string
+	// Raw is the api payload of the results, see WithRawResponses.
+	Raw json.RawMessage
+
+	next func(ctx context.Context, href string) (Page[Playlist], error)
+}
+
+// Page returns the results as a page, which can be passed to NewPaginator.
+func (r SearchPlaylistsResults) Page() Page[Playlist] {
+	return Page[Playlist]{
+		Items:    r.Playlists,
+		NextHref: r.NextHref,
+		next:     r.next,
+	}
+}
@@ -1,23 +1,86 @@
 package soundcloud
 
+import (
+	"context"
+	"encoding/json"
+)
+
 type SearchTracksResults struct {
-	Total  int
-	Tracks []Track
+	Total    int
+	Tracks   []Track
+	NextHref string
+	// Facets requested with WithFacet.
+	Facets map[Facet][]FacetValue
+	// Raw is the api payload of the results, see WithRawResponses.
+	Raw json.RawMessage
+
+	next func(ctx context.Context, href string) (Page[Track], error)
+}
+
+// FacetValue is the number of results matching a value of a facet.
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// Page returns the results as a page, which can be passed to NewPaginator.
+func (r SearchTracksResults) Page() Page[Track] {
+	return Page[Track]{
+		Items:    r.Tracks,
+		NextHref: r.NextHref,
+		next:     r.next,
+	}
 }
 
 type searchTracksAPIResponse struct {
 	Collection   []trackAPIResponse `json:"collection"`
+	NextHref     string             `json:"next_href"`
 	TotalResults int                `json:"total_results"`
+	Facets       []struct {
+		Name   string `json:"name"`
+		Facets []struct {
+			Filter string `json:"filter"`
+			Value  string `json:"value"`
+			Count  int    `json:"count"`
+		} `json:"facets"`
+	} `json:"facets"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *searchTracksAPIResponse) UnmarshalJSON(data []byte) error {
+	type alias searchTracksAPIResponse
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
 }
 
-func (r *searchTracksAPIResponse) toResults() SearchTracksResults {
+func (r *searchTracksAPIResponse) toResults(keepRaw bool) SearchTracksResults {
 	tracks := make([]Track, 0)
 	for _, t := range r.Collection {
-		tracks = append(tracks, t.toTrack())
+		tracks = append(tracks, t.toTrack(keepRaw))
+	}
+
+	facets := make(map[Facet][]FacetValue)
+	for _, f := range r.Facets {
+		values := make([]FacetValue, 0, len(f.Facets))
+		for _, v := range f.Facets {
+			values = append(values, FacetValue{
+				Value: v.Value,
+				Count: v.Count,
+			})
+		}
+		facets[Facet(f.Name)] = values
 	}
 
 	return SearchTracksResults{
-		Total:  r.TotalResults,
-		Tracks: tracks,
+		Total:    r.TotalResults,
+		Tracks:   tracks,
+		NextHref: r.NextHref,
+		Facets:   facets,
+		Raw:      rawPayload(keepRaw, r.Raw),
 	}
 }
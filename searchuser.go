@@ -0,0 +1,25 @@
+package soundcloud
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type SearchUsersResults struct {
+	Total    int
+	Users    []User
+	NextHref string
+	// Raw is the api payload of the results, see WithRawResponses.
+	Raw json.RawMessage
+
+	next func(ctx context.Context, href string) (Page[User], error)
+}
+
+// Page returns the results as a page, which can be passed to NewPaginator.
+func (r SearchUsersResults) Page() Page[User] {
+	return Page[User]{
+		Items:    r.Users,
+		NextHref: r.NextHref,
+		next:     r.next,
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"io"
 	"net/http"
 	liburl "net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/grafov/m3u8"
 	"golang.org/x/net/html"
@@ -18,7 +22,29 @@ import (
 // Soundcloud Client.
 type Client struct {
 	httpClient *http.Client
-	clientId   string
+	clientId   atomic.Value // string
+
+	clientIdStore ClientIDStore
+	clientIdTTL   time.Duration
+	rawResponses  bool
+
+	// guards the fields below, which let concurrent callers share a single refresh.
+	refreshMu sync.Mutex
+	// refresh in flight, if any.
+	refresh *clientIdRefresh
+	// last client id confirmed by a refresh, and when.
+	confirmedId string
+	confirmedAt time.Time
+}
+
+// clientIdRefresh is a client id refresh shared by the callers waiting on it.
+type clientIdRefresh struct {
+	// closed once id and err are set.
+	done chan struct{}
+	id   string
+	err  error
+	// whether id was scraped, rather than loaded from the store.
+	scraped bool
 }
 
 const (
@@ -26,35 +52,54 @@ const (
 	apiURL = "https://api-v2.soundcloud.com"
 )
 
+const (
+	// maximum number of ids accepted by the tracks endpoint.
+	maxTrackIdsPerRequest = 50
+	// maximum number of concurrent requests to the tracks endpoint.
+	maxConcurrentTrackRequests = 4
+	// minimum time before a client id confirmed by a refresh is scraped again.
+	minClientIdRefreshInterval = time.Minute
+)
+
 var (
 	ErrScrapingClientId = errors.New("error while scrapping client id")
 )
 
 // NewClient returns a new Soundcloud client.
 func NewClient(opts ...ClientOption) (*Client, error) {
+	return NewClientContext(context.Background(), opts...)
+}
+
+// NewClientContext returns a new Soundcloud client, using ctx while acquiring the client id.
+func NewClientContext(ctx context.Context, opts ...ClientOption) (*Client, error) {
 	options := defaultClientOptions()
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	c := &Client{
+		httpClient:    options.httpClient,
+		clientIdStore: options.clientIdStore,
+		clientIdTTL:   options.clientIdTTL,
+		rawResponses:  options.rawResponses,
+	}
+
 	clientId := strings.TrimSpace(options.clientId)
-	if len(clientId) == 0 {
-		id, err := scrapClientID(options.httpClient)
+	c.clientId.Store(clientId)
+	if len(clientId) == 0 && !options.lazy {
+		_, err := c.refreshClientID(ctx, clientId)
 		if err != nil {
-			return nil, fmt.Errorf("%w: %w", ErrScrapingClientId, err)
+			return nil, err
 		}
-		clientId = id
 	}
 
-	return &Client{
-		httpClient: options.httpClient,
-		clientId:   clientId,
-	}, nil
+	return c, nil
 }
 
-// ClientId
+// ClientId returns the client id in use, which is empty until acquired for lazy clients.
 func (c *Client) ClientId() string {
-	return c.clientId
+	id, _ := c.clientId.Load().(string)
+	return id
 }
 
 // SearchTracks
@@ -67,18 +112,348 @@ func (c *Client) SearchTracks(ctx context.Context, q string, opts ...SearchOptio
 	return c.searchTracks(ctx, q, options)
 }
 
+// SearchUsers
+func (c *Client) SearchUsers(ctx context.Context, q string, opts ...SearchOption) (SearchUsersResults, error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return c.searchUsers(ctx, q, options)
+}
+
+// SearchPlaylists searches playlists, excluding albums.
+func (c *Client) SearchPlaylists(ctx context.Context, q string, opts ...SearchOption) (SearchPlaylistsResults, error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return c.searchPlaylists(ctx, "search/playlists_without_albums", q, options)
+}
+
+// SearchAlbums
+func (c *Client) SearchAlbums(ctx context.Context, q string, opts ...SearchOption) (SearchPlaylistsResults, error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return c.searchPlaylists(ctx, "search/albums", q, options)
+}
+
+// SearchAll searches tracks, playlists and users at once.
+func (c *Client) SearchAll(ctx context.Context, q string, opts ...SearchOption) (SearchAllResults, error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return c.searchAll(ctx, q, options)
+}
+
+// SuggestQueries returns search queries completing prefix, along with tracks and users it matches.
+func (c *Client) SuggestQueries(ctx context.Context, prefix string, opts ...SearchOption) (QuerySuggestions, error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return c.suggestQueries(ctx, prefix, options)
+}
+
 // GetTrackById
-func (c *Client) GetTrackById(ctx context.Context, id int) (Track, error) {
+func (c *Client) GetTrackById(ctx context.Context, id int64) (Track, error) {
+	return c.getTrackById(ctx, id)
+}
+
+// GetTrackByURN
+func (c *Client) GetTrackByURN(ctx context.Context, urn URN) (Track, error) {
+	id, err := urn.idOf(URNTrack)
+	if err != nil {
+		return Track{}, err
+	}
 	return c.getTrackById(ctx, id)
 }
 
+// GetTracksByIds returns the tracks for ids in the same order, reporting the ones not found as missing.
+func (c *Client) GetTracksByIds(ctx context.Context, ids []int64) (TracksByIdsResults, error) {
+	return c.getTracksByIds(ctx, ids)
+}
+
+// GetTracksByURNs returns the tracks of urns in order, like GetTracksByIds.
+func (c *Client) GetTracksByURNs(ctx context.Context, urns []URN) (TracksByIdsResults, error) {
+	ids := make([]int64, 0, len(urns))
+	for _, urn := range urns {
+		id, err := urn.idOf(URNTrack)
+		if err != nil {
+			return TracksByIdsResults{}, err
+		}
+		ids = append(ids, id)
+	}
+	return c.getTracksByIds(ctx, ids)
+}
+
+// GetTrackComments returns a page of comments on the track.
+func (c *Client) GetTrackComments(ctx context.Context, trackID int64, opts ...SearchOption) (Page[Comment], error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return fetchPage(ctx, c, fmt.Sprintf("tracks/%d/comments", trackID), options.buildComments(), (*commentAPIResponse).toComment)
+}
+
+// GetTrackCommentsByURN returns a page of comments on the track.
+func (c *Client) GetTrackCommentsByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Comment], error) {
+	id, err := urn.idOf(URNTrack)
+	if err != nil {
+		return Page[Comment]{}, err
+	}
+	return c.GetTrackComments(ctx, id, opts...)
+}
+
+// GetRelatedTracks returns a page of tracks similar to the track.
+func (c *Client) GetRelatedTracks(ctx context.Context, trackID int64, opts ...SearchOption) (Page[Track], error) {
+	return getTracksPage(ctx, c, fmt.Sprintf("tracks/%d/related", trackID), opts)
+}
+
+// GetRelatedTracksByURN returns a page of tracks similar to the track.
+func (c *Client) GetRelatedTracksByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Track], error) {
+	id, err := urn.idOf(URNTrack)
+	if err != nil {
+		return Page[Track]{}, err
+	}
+	return c.GetRelatedTracks(ctx, id, opts...)
+}
+
+// GetTrackStation returns a page of tracks of the station based on the track.
+func (c *Client) GetTrackStation(ctx context.Context, trackID int64, opts ...SearchOption) (Page[Track], error) {
+	return getTracksPage(ctx, c, fmt.Sprintf("stations/%s/tracks", NewURN(URNTrackStation, trackID)), opts)
+}
+
+// GetTrackStationByURN returns a page of tracks of the station, or of the station based on the track.
+func (c *Client) GetTrackStationByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Track], error) {
+	id, err := urn.idOf(URNTrackStation, URNTrack)
+	if err != nil {
+		return Page[Track]{}, err
+	}
+	return c.GetTrackStation(ctx, id, opts...)
+}
+
+// GetArtistStation returns a page of tracks of the station based on the user, see User.StationURN.
+func (c *Client) GetArtistStation(ctx context.Context, userID int64, opts ...SearchOption) (Page[Track], error) {
+	return getTracksPage(ctx, c, fmt.Sprintf("stations/%s/tracks", NewURN(URNArtistStation, userID)), opts)
+}
+
+// GetArtistStationByURN returns a page of tracks of the station, such as User.StationURN, or of the station based on the user.
+func (c *Client) GetArtistStationByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Track], error) {
+	id, err := urn.idOf(URNArtistStation, URNUser)
+	if err != nil {
+		return Page[Track]{}, err
+	}
+	return c.GetArtistStation(ctx, id, opts...)
+}
+
+// GetCharts returns a page of the chart of kind for genre.
+// Region is a country code such as "US", or empty for the global chart.
+func (c *Client) GetCharts(ctx context.Context, kind ChartKind, genre ChartGenre, region string, opts ...SearchOption) (Page[ChartEntry], error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	params := options.buildPage()
+	params["kind"] = kind.String()
+	params["genre"] = URN{Kind: URNGenre, ID: genre.String()}.String()
+	if region = strings.TrimSpace(region); len(region) > 0 {
+		params["region"] = URN{Kind: URNRegion, ID: strings.ToUpper(region)}.String()
+	}
+
+	return fetchChartPage(ctx, c, "charts", params)
+}
+
+// GetPlaylistById returns the playlist with all of its tracks.
+func (c *Client) GetPlaylistById(ctx context.Context, id int64) (Playlist, error) {
+	return c.getPlaylistById(ctx, id)
+}
+
+// GetPlaylistByURN returns the playlist with all of its tracks.
+func (c *Client) GetPlaylistByURN(ctx context.Context, urn URN) (Playlist, error) {
+	id, err := urn.idOf(URNPlaylist)
+	if err != nil {
+		return Playlist{}, err
+	}
+	return c.getPlaylistById(ctx, id)
+}
+
+// GetUserById
+func (c *Client) GetUserById(ctx context.Context, id int64) (User, error) {
+	return c.getUserById(ctx, id)
+}
+
+// GetUserByURN
+func (c *Client) GetUserByURN(ctx context.Context, urn URN) (User, error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return User{}, err
+	}
+	return c.getUserById(ctx, id)
+}
+
+// GetUserTracks returns a page of tracks uploaded by the user.
+func (c *Client) GetUserTracks(ctx context.Context, id int64, opts ...SearchOption) (Page[Track], error) {
+	return getTracksPage(ctx, c, fmt.Sprintf("users/%d/tracks", id), opts)
+}
+
+// GetUserTracksByURN returns a page of tracks uploaded by the user.
+func (c *Client) GetUserTracksByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Track], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[Track]{}, err
+	}
+	return c.GetUserTracks(ctx, id, opts...)
+}
+
+// GetUserTopTracks returns a page of the user's most played tracks.
+func (c *Client) GetUserTopTracks(ctx context.Context, id int64, opts ...SearchOption) (Page[Track], error) {
+	return getTracksPage(ctx, c, fmt.Sprintf("users/%d/toptracks", id), opts)
+}
+
+// GetUserTopTracksByURN returns a page of the user's most played tracks.
+func (c *Client) GetUserTopTracksByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Track], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[Track]{}, err
+	}
+	return c.GetUserTopTracks(ctx, id, opts...)
+}
+
+// GetUserPlaylists returns a page of the user's playlists, excluding albums.
+//
+// Playlists in listings only contain the tracks returned in full by the api, use GetPlaylistById to get all of them.
+func (c *Client) GetUserPlaylists(ctx context.Context, id int64, opts ...SearchOption) (Page[Playlist], error) {
+	return getPlaylistsPage(ctx, c, fmt.Sprintf("users/%d/playlists_without_albums", id), opts)
+}
+
+// GetUserPlaylistsByURN returns a page of the user's playlists, excluding albums.
+func (c *Client) GetUserPlaylistsByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Playlist], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[Playlist]{}, err
+	}
+	return c.GetUserPlaylists(ctx, id, opts...)
+}
+
+// GetUserAlbums returns a page of the user's albums, eps and singles.
+//
+// Albums in listings only contain the tracks returned in full by the api, use GetPlaylistById to get all of them.
+func (c *Client) GetUserAlbums(ctx context.Context, id int64, opts ...SearchOption) (Page[Playlist], error) {
+	return getPlaylistsPage(ctx, c, fmt.Sprintf("users/%d/albums", id), opts)
+}
+
+// GetUserAlbumsByURN returns a page of the user's albums, eps and singles.
+func (c *Client) GetUserAlbumsByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Playlist], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[Playlist]{}, err
+	}
+	return c.GetUserAlbums(ctx, id, opts...)
+}
+
+// GetUserFollowers returns a page of users following the user.
+func (c *Client) GetUserFollowers(ctx context.Context, id int64, opts ...SearchOption) (Page[User], error) {
+	return getUsersPage(ctx, c, fmt.Sprintf("users/%d/followers", id), opts)
+}
+
+// GetUserFollowersByURN returns a page of users following the user.
+func (c *Client) GetUserFollowersByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[User], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[User]{}, err
+	}
+	return c.GetUserFollowers(ctx, id, opts...)
+}
+
+// GetUserFollowings returns a page of users the user follows.
+func (c *Client) GetUserFollowings(ctx context.Context, id int64, opts ...SearchOption) (Page[User], error) {
+	return getUsersPage(ctx, c, fmt.Sprintf("users/%d/followings", id), opts)
+}
+
+// GetUserFollowingsByURN returns a page of users the user follows.
+func (c *Client) GetUserFollowingsByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[User], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[User]{}, err
+	}
+	return c.GetUserFollowings(ctx, id, opts...)
+}
+
+// GetUserTrackLikes returns a page of tracks liked by the user, most recent first.
+func (c *Client) GetUserTrackLikes(ctx context.Context, id int64, opts ...SearchOption) (Page[TrackLike], error) {
+	return getPage(ctx, c, fmt.Sprintf("users/%d/track_likes", id), opts, (*trackLikeAPIResponse).toTrackLike)
+}
+
+// GetUserTrackLikesByURN returns a page of tracks liked by the user, most recent first.
+func (c *Client) GetUserTrackLikesByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[TrackLike], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[TrackLike]{}, err
+	}
+	return c.GetUserTrackLikes(ctx, id, opts...)
+}
+
+// GetUserPlaylistLikes returns a page of playlists liked by the user, most recent first.
+func (c *Client) GetUserPlaylistLikes(ctx context.Context, id int64, opts ...SearchOption) (Page[PlaylistLike], error) {
+	return getPage(ctx, c, fmt.Sprintf("users/%d/playlist_likes", id), opts, (*playlistLikeAPIResponse).toPlaylistLike)
+}
+
+// GetUserPlaylistLikesByURN returns a page of playlists liked by the user, most recent first.
+func (c *Client) GetUserPlaylistLikesByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[PlaylistLike], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[PlaylistLike]{}, err
+	}
+	return c.GetUserPlaylistLikes(ctx, id, opts...)
+}
+
+// GetUserReposts returns a page of tracks and playlists reposted by the user, most recent first.
+func (c *Client) GetUserReposts(ctx context.Context, id int64, opts ...SearchOption) (Page[Repost], error) {
+	return getPage(ctx, c, fmt.Sprintf("stream/users/%d/reposts", id), opts, (*repostAPIResponse).toRepost)
+}
+
+// GetUserRepostsByURN returns a page of tracks and playlists reposted by the user, most recent first.
+func (c *Client) GetUserRepostsByURN(ctx context.Context, urn URN, opts ...SearchOption) (Page[Repost], error) {
+	id, err := urn.idOf(URNUser)
+	if err != nil {
+		return Page[Repost]{}, err
+	}
+	return c.GetUserReposts(ctx, id, opts...)
+}
+
+// Resolve returns the track, playlist or user a Soundcloud url points to.
+func (c *Client) Resolve(ctx context.Context, url string) (Resource, error) {
+	return c.resolve(ctx, url)
+}
+
+// GetWaveform
+func (c *Client) GetWaveform(ctx context.Context, track Track) (Waveform, error) {
+	return c.getWaveform(ctx, track)
+}
+
+// DownloadArtwork returns the artwork of the track in size along with its mime type.
+// The uploader's avatar is used when the track has no artwork.
+func (c *Client) DownloadArtwork(ctx context.Context, track Track, size ArtworkSize) ([]byte, string, error) {
+	return c.downloadArtwork(ctx, track, size)
+}
+
 // GetStream
 func (c *Client) GetStream(ctx context.Context, transcoding Transcoding) (io.ReadCloser, error) {
 	return c.getStream(ctx, transcoding)
 }
 
 // GetStreamById
-func (c *Client) GetStreamById(ctx context.Context, id int, opts ...StreamOption) (io.ReadCloser, error) {
+func (c *Client) GetStreamById(ctx context.Context, id int64, opts ...StreamOption) (io.ReadCloser, error) {
 	options := defaultStreamOptions()
 	for _, opt := range opts {
 		opt(options)
@@ -86,22 +461,22 @@ func (c *Client) GetStreamById(ctx context.Context, id int, opts ...StreamOption
 	return c.getStreamById(ctx, id, options)
 }
 
-func (c *Client) getStream(ctx context.Context, transcoding Transcoding) (io.ReadCloser, error) {
-	req, err := c.buildRequest(ctx, strings.TrimPrefix(transcoding.URL, fmt.Sprintf("%s/", apiURL)), nil)
+// GetStreamByURN
+func (c *Client) GetStreamByURN(ctx context.Context, urn URN, opts ...StreamOption) (io.ReadCloser, error) {
+	id, err := urn.idOf(URNTrack)
 	if err != nil {
 		return nil, err
 	}
+	return c.GetStreamById(ctx, id, opts...)
+}
 
-	resp, err := c.httpClient.Do(req)
+func (c *Client) getStream(ctx context.Context, transcoding Transcoding) (io.ReadCloser, error) {
+	resp, err := c.get(ctx, strings.TrimPrefix(transcoding.URL, fmt.Sprintf("%s/", apiURL)), nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
 	v := &struct {
 		URL string `json:"url"`
 	}{}
@@ -130,7 +505,7 @@ func (c *Client) getStream(ctx context.Context, transcoding Transcoding) (io.Rea
 	return pr, nil
 }
 
-func (c *Client) getStreamById(ctx context.Context, id int, opts *streamOptions) (io.ReadCloser, error) {
+func (c *Client) getStreamById(ctx context.Context, id int64, opts *streamOptions) (io.ReadCloser, error) {
 	track, err := c.getTrackById(ctx, id)
 	if err != nil {
 		return nil, err
@@ -173,6 +548,11 @@ func (c *Client) downloadHLS(ctx context.Context, url string, pw *io.PipeWriter)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		pw.CloseWithError(newAPIError(resp))
+		return
+	}
+
 	playlist, listType, err := m3u8.DecodeFrom(resp.Body, true)
 	if err != nil {
 		pw.CloseWithError(err)
@@ -242,6 +622,11 @@ func (c *Client) downloadHLS(ctx context.Context, url string, pw *io.PipeWriter)
 			}
 			defer resp.Body.Close()
 
+			if resp.StatusCode != http.StatusOK {
+				results[idx] = result{idx, nil, newAPIError(resp)}
+				return
+			}
+
 			data, err := io.ReadAll(resp.Body)
 			results[idx] = result{idx, data, err}
 		}(ctx, i, segURI, wg, limit)
@@ -283,7 +668,7 @@ func (c *Client) downloadProgressive(ctx context.Context, url string, pw *io.Pip
 
 	// 200 - 207
 	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusMultiStatus {
-		pw.CloseWithError(fmt.Errorf("unexpected status code: %d", resp.StatusCode))
+		pw.CloseWithError(newAPIError(resp))
 		return
 	}
 
@@ -297,59 +682,471 @@ func (c *Client) downloadProgressive(ctx context.Context, url string, pw *io.Pip
 }
 
 func (c *Client) searchTracks(ctx context.Context, q string, opts *searchOptions) (SearchTracksResults, error) {
+	apiResponse := new(searchTracksAPIResponse)
+	err := c.search(ctx, "search/tracks", q, opts.buildTracks(), apiResponse)
+	if err != nil {
+		return SearchTracksResults{}, err
+	}
+
+	res := apiResponse.toResults(c.rawResponses)
+	res.next = nextPageFunc(c, (*trackAPIResponse).toTrack)
+
+	return res, nil
+}
+
+func (c *Client) searchUsers(ctx context.Context, q string, opts *searchOptions) (SearchUsersResults, error) {
+	apiResponse := new(collectionAPIResponse[userAPIResponse])
+	err := c.search(ctx, "search/users", q, opts.build(), apiResponse)
+	if err != nil {
+		return SearchUsersResults{}, err
+	}
+
+	page := toPage(apiResponse, c.rawResponses, (*userAPIResponse).toUser)
+	return SearchUsersResults{
+		Total:    apiResponse.TotalResults,
+		Users:    page.Items,
+		NextHref: page.NextHref,
+		Raw:      page.Raw,
+		next:     nextPageFunc(c, (*userAPIResponse).toUser),
+	}, nil
+}
+
+func (c *Client) searchPlaylists(ctx context.Context, path string, q string, opts *searchOptions) (SearchPlaylistsResults, error) {
+	apiResponse := new(collectionAPIResponse[playlistAPIResponse])
+	err := c.search(ctx, path, q, opts.build(), apiResponse)
+	if err != nil {
+		return SearchPlaylistsResults{}, err
+	}
+
+	page := toPage(apiResponse, c.rawResponses, (*playlistAPIResponse).toPlaylist)
+	return SearchPlaylistsResults{
+		Total:     apiResponse.TotalResults,
+		Playlists: page.Items,
+		NextHref:  page.NextHref,
+		Raw:       page.Raw,
+		next:      nextPageFunc(c, (*playlistAPIResponse).toPlaylist),
+	}, nil
+}
+
+func (c *Client) searchAll(ctx context.Context, q string, opts *searchOptions) (SearchAllResults, error) {
+	params := opts.build()
+	params["facet"] = modelFacet.String()
+
+	apiResponse := new(searchAllAPIResponse)
+	err := c.search(ctx, "search", q, params, apiResponse)
+	if err != nil {
+		return SearchAllResults{}, err
+	}
+
+	res := apiResponse.toResults(c.rawResponses)
+	res.next = func(ctx context.Context, href string) (Page[Resource], error) {
+		path, params, err := parseHref(href)
+		if err != nil {
+			return Page[Resource]{}, err
+		}
+		return fetchResourcePage(ctx, c, path, params)
+	}
+
+	return res, nil
+}
+
+func (c *Client) suggestQueries(ctx context.Context, prefix string, opts *searchOptions) (QuerySuggestions, error) {
+	apiResponse := new(collectionAPIResponse[suggestionAPIResponse])
+	err := c.search(ctx, "search/queries", prefix, opts.build(), apiResponse)
+	if err != nil {
+		return QuerySuggestions{}, err
+	}
+
+	return toQuerySuggestions(apiResponse, c.rawResponses), nil
+}
+
+// search decodes the results of the search endpoint at path for q and params into v.
+func (c *Client) search(ctx context.Context, path string, q string, params map[string]string, v any) error {
 	q = strings.TrimSpace(q)
 	if len(q) == 0 {
-		return SearchTracksResults{}, fmt.Errorf("search query is required")
+		return fmt.Errorf("search query is required")
 	}
-	opts.q = q
+	params["q"] = q
 
-	req, err := c.buildRequest(ctx, "search/tracks", opts.build())
+	resp, err := c.get(ctx, path, params)
 	if err != nil {
-		return SearchTracksResults{}, err
+		return err
+	}
+	defer resp.Body.Close()
+
+	return c.decode(resp.Body, v)
+}
+
+func (c *Client) getTrackById(ctx context.Context, id int64) (Track, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("tracks/%d", id), nil)
+	if err != nil {
+		return Track{}, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := new(trackAPIResponse)
+	err = c.decode(resp.Body, apiResponse)
+	if err != nil {
+		return Track{}, err
+	}
+
+	return apiResponse.toTrack(c.rawResponses), nil
+}
+
+func (c *Client) getPlaylistById(ctx context.Context, id int64) (Playlist, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("playlists/%d", id), nil)
+	if err != nil {
+		return Playlist{}, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := new(playlistAPIResponse)
+	err = c.decode(resp.Body, apiResponse)
+	if err != nil {
+		return Playlist{}, err
+	}
+
+	return c.hydratePlaylist(ctx, apiResponse)
+}
+
+// hydratePlaylist fetches the tracks the api returned as stubs, reporting the ones that are not accessible as missing.
+func (c *Client) hydratePlaylist(ctx context.Context, r *playlistAPIResponse) (Playlist, error) {
+	playlist := r.toPlaylist(c.rawResponses)
+
+	stubs := r.stubTrackIds()
+	if len(stubs) == 0 {
+		return playlist, nil
+	}
+
+	hydrated, err := c.getTracksByIds(ctx, stubs)
+	if err != nil {
+		return Playlist{}, err
+	}
+
+	byId := make(map[int64]Track, len(hydrated.Tracks))
+	for _, t := range hydrated.Tracks {
+		byId[t.ID] = t
+	}
+
+	tracks := make([]Track, 0, len(r.Tracks))
+	for _, t := range r.Tracks {
+		if !t.isStub() {
+			tracks = append(tracks, t.toTrack(c.rawResponses))
+			continue
+		}
+		if h, ok := byId[t.ID]; ok {
+			tracks = append(tracks, h)
+		}
+	}
+	playlist.Tracks = tracks
+	playlist.MissingTrackIDs = hydrated.Missing
+
+	return playlist, nil
+}
+
+func (c *Client) getTracksByIds(ctx context.Context, ids []int64) (TracksByIdsResults, error) {
+	unique := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	type result struct {
+		tracks []trackAPIResponse
+		err    error
+	}
+
+	chunks := (len(unique) + maxTrackIdsPerRequest - 1) / maxTrackIdsPerRequest
+	results := make([]result, chunks)
+	limit := make(chan struct{}, maxConcurrentTrackRequests)
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < chunks; i++ {
+		start := i * maxTrackIdsPerRequest
+		end := min(start+maxTrackIdsPerRequest, len(unique))
+
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(idx int, ids []int64) {
+			defer wg.Done()
+			defer func() {
+				<-limit
+			}()
+
+			tracks, err := c.getTracksChunk(ctx, ids)
+			results[idx] = result{tracks, err}
+		}(i, unique[start:end])
+	}
+
+	wg.Wait()
+
+	found := make(map[int64]Track, len(unique))
+	for _, r := range results {
+		// a rejected chunk only makes its ids missing.
+		if errors.Is(r.err, ErrNotFound) || errors.Is(r.err, ErrForbidden) {
+			continue
+		}
+		if r.err != nil {
+			return TracksByIdsResults{}, r.err
+		}
+		for _, t := range r.tracks {
+			found[t.ID] = t.toTrack(c.rawResponses)
+		}
+	}
+
+	res := TracksByIdsResults{
+		Tracks:  make([]Track, 0, len(ids)),
+		Missing: make([]int64, 0),
+	}
+	for _, id := range ids {
+		if t, ok := found[id]; ok {
+			res.Tracks = append(res.Tracks, t)
+		} else {
+			res.Missing = append(res.Missing, id)
+		}
+	}
+
+	return res, nil
+}
+
+func (c *Client) getTracksChunk(ctx context.Context, ids []int64) ([]trackAPIResponse, error) {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, strconv.FormatInt(id, 10))
+	}
+
+	resp, err := c.get(ctx, "tracks", map[string]string{"ids": strings.Join(s, ",")})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := make([]trackAPIResponse, 0)
+	err = c.decode(resp.Body, &apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse, nil
+}
+
+func (c *Client) getUserById(ctx context.Context, id int64) (User, error) {
+	resp, err := c.get(ctx, fmt.Sprintf("users/%d", id), nil)
+	if err != nil {
+		return User{}, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := new(userAPIResponse)
+	err = c.decode(resp.Body, apiResponse)
+	if err != nil {
+		return User{}, err
+	}
+
+	return apiResponse.toUser(c.rawResponses), nil
+}
+
+func getTracksPage(ctx context.Context, c *Client, path string, opts []SearchOption) (Page[Track], error) {
+	return getPage(ctx, c, path, opts, (*trackAPIResponse).toTrack)
+}
+
+func getPlaylistsPage(ctx context.Context, c *Client, path string, opts []SearchOption) (Page[Playlist], error) {
+	return getPage(ctx, c, path, opts, (*playlistAPIResponse).toPlaylist)
+}
+
+func getUsersPage(ctx context.Context, c *Client, path string, opts []SearchOption) (Page[User], error) {
+	return getPage(ctx, c, path, opts, (*userAPIResponse).toUser)
+}
+
+// getPage fetches a page of a collection, converting every item of it.
+func getPage[R, T any](ctx context.Context, c *Client, path string, opts []SearchOption, convert func(*R, bool) T) (Page[T], error) {
+	options := defaultSearchOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return fetchPage(ctx, c, path, options.buildPage(), convert)
+}
+
+func fetchPage[R, T any](ctx context.Context, c *Client, path string, params map[string]string, convert func(*R, bool) T) (Page[T], error) {
+	resp, err := c.get(ctx, path, params)
+	if err != nil {
+		return Page[T]{}, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := new(collectionAPIResponse[R])
+	err = c.decode(resp.Body, apiResponse)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	page := toPage(apiResponse, c.rawResponses, convert)
+	page.next = nextPageFunc(c, convert)
+
+	return page, nil
+}
+
+// nextPageFunc returns a func fetching the page at a next_href.
+func nextPageFunc[R, T any](c *Client, convert func(*R, bool) T) func(ctx context.Context, href string) (Page[T], error) {
+	return func(ctx context.Context, href string) (Page[T], error) {
+		path, params, err := parseHref(href)
+		if err != nil {
+			return Page[T]{}, err
+		}
+		return fetchPage(ctx, c, path, params, convert)
+	}
+}
+
+// fetchChartPage fetches a page of a chart, numbering entries from the offset of the page.
+func fetchChartPage(ctx context.Context, c *Client, path string, params map[string]string) (Page[ChartEntry], error) {
+	page, err := fetchPage(ctx, c, path, params, (*chartEntryAPIResponse).toChartEntry)
+	if err != nil {
+		return Page[ChartEntry]{}, err
+	}
+
+	offset, _ := strconv.Atoi(params["offset"])
+	for i := range page.Items {
+		page.Items[i].Position = offset + i + 1
+	}
+
+	page.next = func(ctx context.Context, href string) (Page[ChartEntry], error) {
+		path, params, err := parseHref(href)
+		if err != nil {
+			return Page[ChartEntry]{}, err
+		}
+		return fetchChartPage(ctx, c, path, params)
+	}
+
+	return page, nil
+}
+
+// fetchResourcePage fetches a page of search results, leaving out the kinds that are not handled.
+func fetchResourcePage(ctx context.Context, c *Client, path string, params map[string]string) (Page[Resource], error) {
+	page, err := fetchPage(ctx, c, path, params, (*resourceAPIResponse).toResource)
+	if err != nil {
+		return Page[Resource]{}, err
+	}
+
+	page.Items = slices.DeleteFunc(page.Items, func(r Resource) bool {
+		return r.Track == nil && r.Playlist == nil && r.User == nil
+	})
+
+	page.next = func(ctx context.Context, href string) (Page[Resource], error) {
+		path, params, err := parseHref(href)
+		if err != nil {
+			return Page[Resource]{}, err
+		}
+		return fetchResourcePage(ctx, c, path, params)
+	}
+
+	return page, nil
+}
+
+func (c *Client) getWaveform(ctx context.Context, track Track) (Waveform, error) {
+	if len(track.WaveformURL) == 0 {
+		return Waveform{}, ErrNoWaveform
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, waveformJSONURL(track.WaveformURL), nil)
+	if err != nil {
+		return Waveform{}, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return SearchTracksResults{}, err
+		return Waveform{}, err
 	}
 	defer resp.Body.Close()
 
-	apiResponse := new(searchTracksAPIResponse)
-	err = json.NewDecoder(resp.Body).Decode(apiResponse)
+	if resp.StatusCode != http.StatusOK {
+		return Waveform{}, newAPIError(resp)
+	}
+
+	apiResponse := new(waveformAPIResponse)
+	err = c.decode(resp.Body, apiResponse)
 	if err != nil {
-		return SearchTracksResults{}, err
+		return Waveform{}, err
 	}
 
-	return apiResponse.toResults(), nil
+	return apiResponse.toWaveform(), nil
 }
 
-func (c *Client) getTrackById(ctx context.Context, id int) (Track, error) {
-	req, err := c.buildRequest(ctx, fmt.Sprintf("tracks/%d", id), nil)
+func (c *Client) downloadArtwork(ctx context.Context, track Track, size ArtworkSize) ([]byte, string, error) {
+	url := track.Artwork(size)
+	if len(url) == 0 {
+		return nil, "", ErrNoArtwork
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return Track{}, err
+		return nil, "", err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return Track{}, err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return Track{}, fmt.Errorf("invalid id")
+		return nil, "", newAPIError(resp)
 	}
 
-	apiResponse := new(trackAPIResponse)
-	err = json.NewDecoder(resp.Body).Decode(apiResponse)
+	data, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return Track{}, err
+		return nil, "", err
 	}
 
-	return apiResponse.toTrack(), nil
+	return data, http.DetectContentType(data), nil
 }
 
-func scrapClientID(httpClient *http.Client) (string, error) {
-	resp, err := httpClient.Get(webURL)
+func (c *Client) resolve(ctx context.Context, url string) (Resource, error) {
+	url = strings.TrimSpace(url)
+	if len(url) == 0 {
+		return Resource{}, fmt.Errorf("url is required")
+	}
+
+	resp, err := c.get(ctx, "resolve", map[string]string{"url": url})
+	if err != nil {
+		return Resource{}, err
+	}
+	defer resp.Body.Close()
+
+	apiResponse := new(resourceAPIResponse)
+	err = c.decode(resp.Body, apiResponse)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	if !apiResponse.isSupported() {
+		return Resource{}, &UnsupportedKindError{Kind: apiResponse.Kind}
+	}
+
+	res := apiResponse.toResource(c.rawResponses)
+
+	// resolved playlists have the same stub tracks as the ones fetched by id.
+	if apiResponse.Playlist != nil {
+		playlist, err := c.hydratePlaylist(ctx, apiResponse.Playlist)
+		if err != nil {
+			return Resource{}, err
+		}
+		res.Playlist = &playlist
+	}
+
+	return res, nil
+}
+
+func scrapClientID(ctx context.Context, httpClient *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, webURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("error requesting url: %w", err)
 	}
@@ -381,7 +1178,12 @@ func scrapClientID(httpClient *http.Client) (string, error) {
 		return "", fmt.Errorf("no urls found in response")
 	}
 
-	resp, err = httpClient.Get(urls[len(urls)-1])
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, urls[len(urls)-1], nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err = httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("error requesting url: %w", err)
 	}
@@ -414,7 +1216,7 @@ func (c *Client) buildRequest(ctx context.Context, path string, params map[strin
 	req.Header.Set("Content-Type", "application/json")
 
 	p := req.URL.Query()
-	p.Set("client_id", c.clientId)
+	p.Set("client_id", c.ClientId())
 
 	for k, v := range params {
 		p.Set(k, v)
@@ -423,3 +1225,138 @@ func (c *Client) buildRequest(ctx context.Context, path string, params map[strin
 
 	return req, nil
 }
+
+// get performs a GET request against the api and returns the response if its status is 200.
+//
+// When the api rejects the client id, it is refreshed and the request is replayed once.
+func (c *Client) get(ctx context.Context, path string, params map[string]string) (*http.Response, error) {
+	clientId := c.ClientId()
+	if len(clientId) == 0 {
+		id, err := c.refreshClientID(ctx, clientId)
+		if err != nil {
+			return nil, err
+		}
+		clientId = id
+	}
+
+	resp, err := c.do(ctx, path, params)
+	if err == nil || !isClientIdRejected(err) {
+		return resp, err
+	}
+
+	id, refreshErr := c.refreshClientID(ctx, clientId)
+	if refreshErr != nil {
+		return nil, errors.Join(err, refreshErr)
+	}
+	// the client id is still valid, so the request was rejected for another reason.
+	if id == clientId {
+		return nil, err
+	}
+
+	return c.do(ctx, path, params)
+}
+
+func (c *Client) do(ctx context.Context, path string, params map[string]string) (*http.Response, error) {
+	req, err := c.buildRequest(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, newAPIError(resp)
+	}
+
+	return resp, nil
+}
+
+// decode decodes an api response from r into v.
+func (c *Client) decode(r io.Reader, v any) error {
+	return json.NewDecoder(r).Decode(v)
+}
+
+// refreshClientID replaces the stale client id unless it has already been replaced,
+// or was recently confirmed by a refresh returning the same id.
+//
+// Concurrent callers share the refresh in flight, each waiting on it until its own ctx is done.
+func (c *Client) refreshClientID(ctx context.Context, stale string) (string, error) {
+	for {
+		c.refreshMu.Lock()
+		if id := c.ClientId(); id != stale {
+			c.refreshMu.Unlock()
+			return id, nil
+		}
+		if len(stale) > 0 && stale == c.confirmedId && time.Since(c.confirmedAt) < minClientIdRefreshInterval {
+			c.refreshMu.Unlock()
+			return stale, nil
+		}
+
+		r := c.refresh
+		if r == nil {
+			r = &clientIdRefresh{done: make(chan struct{})}
+			c.refresh = r
+			c.refreshMu.Unlock()
+
+			c.runRefresh(ctx, r, stale)
+			return r.id, r.err
+		}
+		c.refreshMu.Unlock()
+
+		select {
+		case <-r.done:
+		case <-ctx.Done():
+			return "", fmt.Errorf("%w: %w", ErrScrapingClientId, ctx.Err())
+		}
+
+		// the refresh was given up by the caller running it, not because it failed.
+		if errors.Is(r.err, context.Canceled) || errors.Is(r.err, context.DeadlineExceeded) {
+			continue
+		}
+		return r.id, r.err
+	}
+}
+
+// runRefresh acquires a new client id for r using ctx, preferring a fresh one from the store over scraping.
+func (c *Client) runRefresh(ctx context.Context, r *clientIdRefresh, stale string) {
+	defer func() {
+		c.refreshMu.Lock()
+		if r.err == nil {
+			c.clientId.Store(r.id)
+		}
+		if r.scraped {
+			c.confirmedId, c.confirmedAt = r.id, time.Now()
+		}
+		c.refresh = nil
+		c.refreshMu.Unlock()
+		close(r.done)
+	}()
+
+	if c.clientIdStore != nil {
+		id, savedAt, err := c.clientIdStore.Load()
+		if err == nil && len(id) > 0 && id != stale && time.Since(savedAt) < c.clientIdTTL {
+			r.id = id
+			return
+		}
+	}
+
+	id, err := scrapClientID(ctx, c.httpClient)
+	if err != nil {
+		r.err = fmt.Errorf("%w: %w", ErrScrapingClientId, err)
+		return
+	}
+	r.id, r.scraped = id, true
+
+	if c.clientIdStore != nil {
+		// the scraped id is usable even if it could not be cached.
+		_ = c.clientIdStore.Save(id, time.Now())
+	}
+}
+
+func isClientIdRejected(err error) bool {
+	return errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden)
+}
@@ -1,9 +1,22 @@
 package soundcloud_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ppalone/soundcloud"
 	"github.com/stretchr/testify/assert"
@@ -43,6 +56,32 @@ func Test_SearchTracks(t *testing.T) {
 		}
 	})
 
+	t.Run("with filters and facet", func(t *testing.T) {
+		q := "nujabes"
+		res, err := c.SearchTracks(context.Background(), q,
+			soundcloud.WithDurationRange(2*time.Minute, 10*time.Minute),
+			soundcloud.WithLicense(soundcloud.LicenseToListen),
+			soundcloud.WithFacet(soundcloud.GenreFacet),
+		)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Tracks)
+		assert.NotEmpty(t, res.Facets[soundcloud.GenreFacet])
+	})
+
+	t.Run("with paginator", func(t *testing.T) {
+		q := "nujabes"
+		res, err := c.SearchTracks(context.Background(), q, soundcloud.WithLimit(50))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.NextHref)
+
+		count := 0
+		for _, err := range soundcloud.NewPaginator(res.Page(), soundcloud.WithMaxItems(120)).All(context.Background()) {
+			assert.NoError(t, err)
+			count += 1
+		}
+		assert.Equal(t, 120, count)
+	})
+
 	t.Run("with client id option", func(t *testing.T) {
 		c2, err := soundcloud.NewClient(soundcloud.WithClientID(c.ClientId()))
 		assert.NoError(t, err)
@@ -60,23 +99,310 @@ func Test_SearchTracks(t *testing.T) {
 
 }
 
+func Test_SearchFilters(t *testing.T) {
+	c, ft := newFakeClient(t, map[string]string{
+		"/search/tracks?offset=0": `{"collection":[],"next_href":null,"facets":[{"name":"genre","facets":[{"filter":"genre","value":"house","count":2}]}]}`,
+		"/search/users?offset=0":  `{"collection":[],"next_href":null}`,
+	})
+
+	opts := []soundcloud.SearchOption{
+		soundcloud.WithGenre("house"),
+		soundcloud.WithTags("big room", "edm"),
+		soundcloud.WithDurationRange(2*time.Minute, 10*time.Minute),
+		soundcloud.WithCreatedAtRange(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), time.Time{}),
+		soundcloud.WithLicense(soundcloud.LicenseToShare),
+		soundcloud.WithBPMRange(120, 130),
+		soundcloud.WithFacet(soundcloud.GenreFacet),
+	}
+
+	t.Run("search tracks", func(t *testing.T) {
+		res, err := c.SearchTracks(context.Background(), "test", opts...)
+		assert.NoError(t, err)
+		assert.Equal(t, []soundcloud.FacetValue{{Value: "house", Count: 2}}, res.Facets[soundcloud.GenreFacet])
+
+		q := ft.queries["/search/tracks"]
+		assert.Equal(t, "test", q.Get("q"))
+		assert.Equal(t, "house", q.Get("filter.genre"))
+		assert.Equal(t, "big room,edm", q.Get("filter.tags"))
+		assert.Equal(t, "120000", q.Get("filter.duration[from]"))
+		assert.Equal(t, "600000", q.Get("filter.duration[to]"))
+		assert.Equal(t, "2020-01-02 03:04:05", q.Get("filter.created_at[from]"))
+		assert.False(t, q.Has("filter.created_at[to]"))
+		assert.Equal(t, "to_share", q.Get("filter.license"))
+		assert.Equal(t, "120", q.Get("filter.bpm[from]"))
+		assert.Equal(t, "130", q.Get("filter.bpm[to]"))
+		assert.Equal(t, "genre", q.Get("facet"))
+	})
+
+	t.Run("search users", func(t *testing.T) {
+		_, err := c.SearchUsers(context.Background(), "test", opts...)
+		assert.NoError(t, err)
+
+		for k := range ft.queries["/search/users"] {
+			assert.NotContains(t, k, "filter.")
+			assert.NotEqual(t, "facet", k)
+		}
+	})
+}
+
+func Test_SearchAllTotals(t *testing.T) {
+	c, ft := newFakeClient(t, map[string]string{
+		"/search?offset=0": `{"collection":[{"kind":"track","id":1},{"kind":"user","id":2},{"kind":"system-playlist","id":3}],"total_results":150,"next_href":"https://api-v2.soundcloud.com/search?q=test&offset=3&limit=3","facets":[{"name":"model","facets":[{"filter":"model","value":"track","count":100},{"filter":"model","value":"user","count":30},{"filter":"model","value":"playlist","count":20}]}]}`,
+		"/search?offset=3": `{"collection":[{"kind":"system-playlist","id":4},{"kind":"playlist","id":5}],"next_href":null}`,
+	})
+
+	res, err := c.SearchAll(context.Background(), "test")
+	assert.NoError(t, err)
+	assert.Len(t, res.Results, 2)
+	assert.Equal(t, soundcloud.USER, res.Results[1].Kind)
+	assert.Equal(t, "model", ft.queries["/search"].Get("facet"))
+	assert.Equal(t, map[soundcloud.ResourceKind]int{
+		soundcloud.TRACK:    100,
+		soundcloud.USER:     30,
+		soundcloud.PLAYLIST: 20,
+	}, res.Totals)
+
+	next, err := res.Page().Next(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, next.Items, 1)
+	assert.Equal(t, soundcloud.PLAYLIST, next.Items[0].Kind)
+}
+
+func Test_Search(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	t.Run("search users", func(t *testing.T) {
+		res, err := c.SearchUsers(context.Background(), "nujabes", soundcloud.WithLimit(5))
+		assert.NoError(t, err)
+		assert.Len(t, res.Users, 5)
+	})
+
+	t.Run("search albums", func(t *testing.T) {
+		res, err := c.SearchAlbums(context.Background(), "nujabes", soundcloud.WithLimit(5))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Playlists)
+	})
+
+	t.Run("suggest queries", func(t *testing.T) {
+		limit := 5
+		res, err := c.SuggestQueries(context.Background(), "nuja", soundcloud.WithLimit(limit))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Queries)
+		assert.LessOrEqual(t, len(res.Queries)+len(res.Tracks)+len(res.Users), limit)
+	})
+
+	t.Run("search all", func(t *testing.T) {
+		res, err := c.SearchAll(context.Background(), "nujabes")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Results)
+		assert.Greater(t, res.Totals[soundcloud.TRACK], len(res.Results))
+	})
+}
+
 func Test_GetTrackById(t *testing.T) {
 	c, err := soundcloud.NewClient()
 	assert.NoError(t, err)
 
 	t.Run("with valid id", func(t *testing.T) {
-		id := 98081145 // Martin Garrix - Animals
+		id := int64(98081145) // Martin Garrix - Animals
 		res, err := c.GetTrackById(context.Background(), id)
 		assert.NoError(t, err)
 		assert.Equal(t, res.ID, id)
 		assert.Contains(t, res.Title, "Animals")
 		assert.NotEmpty(t, res.Transcodings)
+		assert.Greater(t, res.Duration, time.Minute)
+		assert.Equal(t, soundcloud.NewURN(soundcloud.URNTrack, id), res.URN)
+		assert.NotEmpty(t, res.PermalinkURL)
+		assert.NotZero(t, res.PlaybackCount)
 	})
 
 	t.Run("with invalid id", func(t *testing.T) {
-		id := 0
+		id := int64(0)
 		_, err := c.GetTrackById(context.Background(), id)
-		assert.ErrorContains(t, err, "invalid id")
+
+		var apiErr *soundcloud.APIError
+		assert.ErrorAs(t, err, &apiErr)
+	})
+}
+
+func Test_GetTrackComments(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	id := int64(98081145) // Martin Garrix - Animals
+	limit := 10
+	res, err := c.GetTrackComments(context.Background(), id, soundcloud.WithLimit(limit), soundcloud.WithCommentOrder(soundcloud.CommentOrderTimestamp))
+	assert.NoError(t, err)
+	assert.Len(t, res.Items, limit)
+	assert.NotEmpty(t, res.NextHref)
+
+	for i := 1; i < len(res.Items); i++ {
+		assert.LessOrEqual(t, res.Items[i-1].Timestamp, res.Items[i].Timestamp)
+	}
+}
+
+func Test_GetTrackCommentsOffline(t *testing.T) {
+	c, ft := newFakeClient(t, map[string]string{
+		"/tracks/1/comments?offset=10": `{"collection":[],"next_href":null}`,
+		"/tracks/1/comments?offset=0":  `{"collection":[{"kind":"comment","id":2,"body":"drop","created_at":"2015-06-17T10:00:00Z","timestamp":61500,"track_id":1,"user_id":3,"self":{"urn":"soundcloud:comments:2"},"user":{"id":3,"username":"test"}}],"next_href":null}`,
+	})
+
+	res, err := c.GetTrackComments(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, res.Items, 1)
+	assert.Equal(t, int64(2), res.Items[0].ID)
+	assert.Equal(t, "drop", res.Items[0].Body)
+	assert.Equal(t, 61500*time.Millisecond, res.Items[0].Timestamp)
+	assert.Equal(t, "test", res.Items[0].User.Username)
+	assert.False(t, res.HasNext())
+
+	_, err = c.GetTrackComments(context.Background(), 1,
+		soundcloud.WithLimit(5),
+		soundcloud.WithOffset(10),
+		soundcloud.WithThreaded(true),
+		soundcloud.WithCommentOrder(soundcloud.CommentOrderTimestamp),
+	)
+	assert.NoError(t, err)
+
+	q := ft.queries["/tracks/1/comments"]
+	assert.Equal(t, "5", q.Get("limit"))
+	assert.Equal(t, "10", q.Get("offset"))
+	assert.Equal(t, "1", q.Get("threaded"))
+	assert.Equal(t, "timestamp", q.Get("sort"))
+}
+
+func Test_RelatedTracks(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	id := int64(98081145) // Martin Garrix - Animals
+	track, err := c.GetTrackById(context.Background(), id)
+	assert.NoError(t, err)
+
+	t.Run("get related tracks", func(t *testing.T) {
+		res, err := c.GetRelatedTracks(context.Background(), id)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Items)
+	})
+
+	t.Run("get track station", func(t *testing.T) {
+		res, err := c.GetTrackStation(context.Background(), id)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Items)
+	})
+
+	t.Run("get artist station", func(t *testing.T) {
+		assert.NotEmpty(t, track.User.StationURN)
+		res, err := c.GetArtistStation(context.Background(), track.User.ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Items)
+	})
+}
+
+func Test_GetCharts(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	limit := 10
+	res, err := c.GetCharts(context.Background(), soundcloud.TRENDING, soundcloud.GenreDanceEDM, "US", soundcloud.WithLimit(limit))
+	assert.NoError(t, err)
+	assert.Len(t, res.Items, limit)
+
+	for i, entry := range res.Items {
+		assert.Equal(t, i+1, entry.Position)
+		assert.NotZero(t, entry.Track.ID)
+	}
+}
+
+func Test_Waveform(t *testing.T) {
+	t.Run("get waveform", func(t *testing.T) {
+		c, err := soundcloud.NewClient()
+		assert.NoError(t, err)
+
+		id := int64(98081145) // Martin Garrix - Animals
+		track, err := c.GetTrackById(context.Background(), id)
+		assert.NoError(t, err)
+
+		w, err := c.GetWaveform(context.Background(), track)
+		assert.NoError(t, err)
+		assert.NotZero(t, w.Height)
+		assert.Len(t, w.Samples, w.Width)
+	})
+
+	t.Run("resample and normalize", func(t *testing.T) {
+		w := soundcloud.Waveform{Width: 6, Height: 10, Samples: []int{1, 4, 2, 8, 0, 2}}
+
+		down := w.Resample(3)
+		assert.Equal(t, []int{4, 8, 2}, down.Samples)
+		assert.Equal(t, 3, down.Width)
+
+		up := w.Resample(12)
+		assert.Len(t, up.Samples, 12)
+		assert.Equal(t, []int{1, 1, 4, 4}, up.Samples[:4])
+
+		assert.Equal(t, []float64{0.125, 0.5, 0.25, 1, 0, 0.25}, w.Normalize())
+	})
+}
+
+func Test_RenderWaveform(t *testing.T) {
+	w := soundcloud.Waveform{Width: 6, Height: 10, Samples: []int{1, 4, 2, 8, 0, 2}}
+	opts := []soundcloud.RenderOption{
+		soundcloud.WithSize(30, 10),
+		soundcloud.WithBars(2, 1),
+		soundcloud.WithColors(color.White, color.Black, color.RGBA{0xff, 0, 0, 0xff}),
+		soundcloud.WithProgress(0.5),
+	}
+
+	t.Run("svg", func(t *testing.T) {
+		sb := &strings.Builder{}
+		err := w.RenderSVG(sb, opts...)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(sb.String(), `<svg xmlns="http://www.w3.org/2000/svg" width="30" height="10"`))
+		assert.Equal(t, 11, strings.Count(sb.String(), "<rect"))
+		assert.Equal(t, 5, strings.Count(sb.String(), `fill="#ff0000"`))
+	})
+
+	t.Run("png", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := w.RenderPNG(buf, opts...)
+		assert.NoError(t, err)
+
+		img, err := png.Decode(buf)
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 30, 10), img.Bounds())
+	})
+
+	t.Run("with invalid size", func(t *testing.T) {
+		err := w.RenderPNG(io.Discard, soundcloud.WithSize(0, 10))
+		assert.Error(t, err)
+	})
+}
+
+func Test_Artwork(t *testing.T) {
+	t.Run("resize artwork url", func(t *testing.T) {
+		url := "https://i1.sndcdn.com/artworks-000051486476-x3mq3o-large.jpg"
+		assert.Equal(t, "https://i1.sndcdn.com/artworks-000051486476-x3mq3o-t500x500.jpg", soundcloud.ResizeArtworkURL(url, soundcloud.ArtworkT500))
+		assert.Equal(t, "https://example.com/image.jpg", soundcloud.ResizeArtworkURL("https://example.com/image.jpg", soundcloud.ArtworkT500))
+	})
+
+	t.Run("fallback to avatar", func(t *testing.T) {
+		track := soundcloud.Track{User: soundcloud.User{AvatarURL: "https://i1.sndcdn.com/avatars-000123-abc-large.jpg"}}
+		assert.Equal(t, "https://i1.sndcdn.com/avatars-000123-abc-crop.jpg", track.Artwork(soundcloud.ArtworkCrop))
+	})
+
+	t.Run("download artwork", func(t *testing.T) {
+		c, err := soundcloud.NewClient()
+		assert.NoError(t, err)
+
+		id := int64(98081145) // Martin Garrix - Animals
+		track, err := c.GetTrackById(context.Background(), id)
+		assert.NoError(t, err)
+
+		data, mimeType, err := c.DownloadArtwork(context.Background(), track, soundcloud.ArtworkT500)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, data)
+		assert.Equal(t, "image/jpeg", mimeType)
 	})
 }
 
@@ -128,12 +454,9 @@ func Test_Stream(t *testing.T) {
 		transcoding := soundcloud.Transcoding{
 			URL:      "https://api-v2.soundcloud.com/media/soundcloud:tracks:98081145/d27e0e2b-16e6-4fb2-b2a2-49bb6ad7e489/stream/hls", // invalid url
 			Preset:   "mp3_0_1",
-			Duration: 304300,
+			Duration: 304300 * time.Millisecond,
 			Snipped:  false,
-			Format: struct {
-				Protocol string
-				MimeType string
-			}{
+			Format: soundcloud.TranscodingFormat{
 				Protocol: "hls",
 				MimeType: "audio/mpeg",
 			},
@@ -142,6 +465,7 @@ func Test_Stream(t *testing.T) {
 		}
 		stream, err := c.GetStream(context.Background(), transcoding)
 		assert.ErrorContains(t, err, "404")
+		assert.ErrorIs(t, err, soundcloud.ErrNotFound)
 		assert.Nil(t, stream)
 	})
 }
@@ -151,16 +475,773 @@ func Test_GetStreamById(t *testing.T) {
 	assert.NoError(t, err)
 
 	t.Run("with valid id and available stream options", func(t *testing.T) {
-		id := 98081145
+		id := int64(98081145)
 		stream, err := c.GetStreamById(context.Background(), id, soundcloud.WithPreset(soundcloud.MP3), soundcloud.WithProtocol(soundcloud.PROGRESSIVE))
 		assert.NoError(t, err)
 		assert.NotNil(t, stream)
 	})
 
 	t.Run("with valid id and unavailable stream options", func(t *testing.T) {
-		id := 98081145
+		id := int64(98081145)
 		stream, err := c.GetStreamById(context.Background(), id, soundcloud.WithPreset(soundcloud.AAC), soundcloud.WithProtocol(soundcloud.PROGRESSIVE))
 		assert.ErrorContains(t, err, "transcoding with preset aac and protocol progressive not found for track")
 		assert.Nil(t, stream)
 	})
 }
+
+// fakeTransport serves the soundcloud homepage, asset bundle and api from memory.
+type fakeTransport struct {
+	mu       sync.Mutex
+	clientId string
+	scrapes  int
+
+	// api responses by path and offset, such as "/users/1/tracks?offset=0".
+	routes map[string]string
+	// api status codes by path, defaulting to 200.
+	statuses map[string]int
+	// api queries received, by path.
+	queries map[string]url.Values
+	// when set, scrapes of the homepage wait for it to be closed.
+	scrapeGate chan struct{}
+	// api handlers by path, returning a status code and body, served before routes.
+	handlers map[string]func(q url.Values) (int, string)
+	// api requests received, by path.
+	requests map[string]int
+}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == "soundcloud.com" {
+		f.mu.Lock()
+		f.scrapes += 1
+		f.mu.Unlock()
+
+		if f.scrapeGate != nil {
+			select {
+			case <-f.scrapeGate:
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			}
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	respond := func(code int, body string) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: code,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+
+	switch req.URL.Host {
+	case "cf-media.sndcdn.com":
+		return respond(http.StatusOK, "audio")
+	case "soundcloud.com":
+		return respond(http.StatusOK, `<html><script src="https://a-v2.sndcdn.com/assets/0-test.js"></script></html>`)
+	case "a-v2.sndcdn.com":
+		return respond(http.StatusOK, fmt.Sprintf(`{a:1,client_id:"%s",b:2}`, f.clientId))
+	}
+
+	if req.URL.Query().Get("client_id") != f.clientId {
+		return respond(http.StatusUnauthorized, `{}`)
+	}
+	if f.queries != nil {
+		f.queries[req.URL.Path] = req.URL.Query()
+	}
+	if f.requests != nil {
+		f.requests[req.URL.Path] += 1
+	}
+	if handle, ok := f.handlers[req.URL.Path]; ok {
+		return respond(handle(req.URL.Query()))
+	}
+	if code, ok := f.statuses[req.URL.Path]; ok {
+		return respond(code, `{}`)
+	}
+	if f.routes != nil {
+		body, ok := f.routes[req.URL.Path+"?offset="+req.URL.Query().Get("offset")]
+		if !ok {
+			return respond(http.StatusNotFound, `{}`)
+		}
+		return respond(http.StatusOK, body)
+	}
+	return respond(http.StatusOK, `{"id":1,"kind":"track","title":"Test"}`)
+}
+
+// newFakeClient returns a client using the "fresh" client id, and the fakeTransport serving it routes.
+func newFakeClient(t *testing.T, routes map[string]string, opts ...soundcloud.ClientOption) (*soundcloud.Client, *fakeTransport) {
+	t.Helper()
+
+	ft := &fakeTransport{clientId: "fresh", routes: routes, queries: map[string]url.Values{}, requests: map[string]int{}}
+	opts = append([]soundcloud.ClientOption{soundcloud.WithHTTPClient(&http.Client{Transport: ft}), soundcloud.WithClientID("fresh")}, opts...)
+	c, err := soundcloud.NewClient(opts...)
+	assert.NoError(t, err)
+
+	return c, ft
+}
+
+func Test_ClientIdRefresh(t *testing.T) {
+	c, ft := newFakeClient(t, nil, soundcloud.WithClientID("stale"))
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := c.GetTrackById(context.Background(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, "Test", res.Title)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, "fresh", c.ClientId())
+	assert.Equal(t, 1, ft.scrapes)
+
+	t.Run("with same scraped client id", func(t *testing.T) {
+		c, ft := newFakeClient(t, nil)
+		ft.statuses = map[string]int{"/tracks/1": http.StatusForbidden}
+
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := c.GetTrackById(context.Background(), 1)
+				assert.ErrorIs(t, err, soundcloud.ErrForbidden)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 1, ft.scrapes)
+	})
+}
+
+func Test_ClientIDStore(t *testing.T) {
+	t.Run("with fresh stored client id", func(t *testing.T) {
+		store := soundcloud.NewFileClientIDStore(filepath.Join(t.TempDir(), "client_id.json"))
+		assert.NoError(t, store.Save("fresh", time.Now()))
+
+		c, ft := newFakeClient(t, nil, soundcloud.WithClientID(""), soundcloud.WithClientIDStore(store))
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 0, ft.scrapes)
+	})
+
+	t.Run("with expired stored client id", func(t *testing.T) {
+		store := soundcloud.NewFileClientIDStore(filepath.Join(t.TempDir(), "client_id.json"))
+		assert.NoError(t, store.Save("old", time.Now().Add(-48*time.Hour)))
+
+		c, ft := newFakeClient(t, nil, soundcloud.WithClientID(""), soundcloud.WithClientIDStore(store))
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 1, ft.scrapes)
+
+		id, _, err := store.Load()
+		assert.NoError(t, err)
+		assert.Equal(t, "fresh", id)
+	})
+
+	t.Run("with rejected stored client id", func(t *testing.T) {
+		store := soundcloud.NewFileClientIDStore(filepath.Join(t.TempDir(), "client_id.json"))
+		assert.NoError(t, store.Save("stale", time.Now()))
+
+		c, ft := newFakeClient(t, nil, soundcloud.WithClientID(""), soundcloud.WithClientIDStore(store))
+
+		_, err := c.GetTrackById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 1, ft.scrapes)
+	})
+}
+
+func Test_NewClientContext(t *testing.T) {
+	t.Run("with cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		ft := &fakeTransport{clientId: "fresh"}
+		_, err := soundcloud.NewClientContext(ctx, soundcloud.WithHTTPClient(&http.Client{Transport: ft}))
+		assert.ErrorIs(t, err, soundcloud.ErrScrapingClientId)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("with deadline while another caller scrapes", func(t *testing.T) {
+		c, ft := newFakeClient(t, nil, soundcloud.WithClientID(""), soundcloud.WithLazyClientID())
+		ft.scrapeGate = make(chan struct{})
+
+		done := make(chan error)
+		go func() {
+			_, err := c.GetTrackById(context.Background(), 1)
+			done <- err
+		}()
+		assert.Eventually(t, func() bool {
+			ft.mu.Lock()
+			defer ft.mu.Unlock()
+			return ft.scrapes == 1
+		}, time.Second, time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := c.GetTrackById(ctx, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		close(ft.scrapeGate)
+		assert.NoError(t, <-done)
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 1, ft.scrapes)
+	})
+
+	t.Run("with lazy client id", func(t *testing.T) {
+		c, ft := newFakeClient(t, nil, soundcloud.WithClientID(""), soundcloud.WithLazyClientID())
+		assert.Empty(t, c.ClientId())
+		assert.Equal(t, 0, ft.scrapes)
+
+		_, err := c.GetTrackById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "fresh", c.ClientId())
+		assert.Equal(t, 1, ft.scrapes)
+	})
+}
+
+func Test_Resolve(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	t.Run("with track url", func(t *testing.T) {
+		res, err := c.Resolve(context.Background(), "https://soundcloud.com/martingarrix/martin-garrix-animals-original")
+		assert.NoError(t, err)
+		assert.Equal(t, soundcloud.TRACK, res.Kind)
+		assert.NotNil(t, res.Track)
+		assert.Contains(t, res.Track.Title, "Animals")
+	})
+
+	t.Run("with user url", func(t *testing.T) {
+		res, err := c.Resolve(context.Background(), "https://soundcloud.com/martingarrix")
+		assert.NoError(t, err)
+		assert.Equal(t, soundcloud.USER, res.Kind)
+		assert.NotNil(t, res.User)
+	})
+
+	t.Run("with invalid url", func(t *testing.T) {
+		_, err := c.Resolve(context.Background(), "https://soundcloud.com/this-user-does-not-exist-123456789/nope")
+		assert.ErrorIs(t, err, soundcloud.ErrNotFound)
+	})
+}
+
+func Test_GetPlaylistById(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	res, err := c.Resolve(context.Background(), "https://soundcloud.com/martingarrix/sets/sentio")
+	assert.NoError(t, err)
+	assert.Equal(t, soundcloud.PLAYLIST, res.Kind)
+
+	playlist, err := c.GetPlaylistById(context.Background(), res.Playlist.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, playlist.TrackCount, len(playlist.Tracks)+len(playlist.MissingTrackIDs))
+
+	for _, track := range playlist.Tracks {
+		assert.NotEmpty(t, track.Title)
+		assert.NotEmpty(t, track.Transcodings)
+	}
+}
+
+func Test_PlaylistHydration(t *testing.T) {
+	c, _ := newFakeClient(t, map[string]string{
+		"/playlists/1?offset=": `{"id":1,"track_count":3,"tracks":[{"id":2,"title":"Full"},{"id":3},{"id":4}]}`,
+		"/tracks?offset=":      `[{"id":4,"title":"Hydrated"}]`,
+	})
+
+	playlist, err := c.GetPlaylistById(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, playlist.Tracks, 2)
+	assert.Equal(t, "Full", playlist.Tracks[0].Title)
+	assert.Equal(t, "Hydrated", playlist.Tracks[1].Title)
+	assert.Equal(t, []int64{3}, playlist.MissingTrackIDs)
+}
+
+func Test_GetTracksByIds(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	q := "nujabes"
+	limit := 60
+	search, err := c.SearchTracks(context.Background(), q, soundcloud.WithLimit(limit))
+	assert.NoError(t, err)
+
+	ids := []int64{0}
+	for i := len(search.Tracks) - 1; i >= 0; i-- {
+		ids = append(ids, search.Tracks[i].ID)
+	}
+
+	res, err := c.GetTracksByIds(context.Background(), ids)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, res.Missing)
+	assert.Len(t, res.Tracks, len(ids)-1)
+
+	for i, track := range res.Tracks {
+		assert.Equal(t, ids[i+1], track.ID)
+	}
+}
+
+func Test_GetTracksByIdsOffline(t *testing.T) {
+	c, ft := newFakeClient(t, nil)
+	ft.handlers = map[string]func(q url.Values) (int, string){
+		"/tracks": func(q url.Values) (int, string) {
+			ids := strings.Split(q.Get("ids"), ",")
+			if slices.Contains(ids, "51") {
+				return http.StatusNotFound, `{}`
+			}
+			// tracks come back out of order, without the inaccessible track 7.
+			tracks := []string{}
+			for _, id := range slices.Backward(ids) {
+				if id != "7" {
+					tracks = append(tracks, fmt.Sprintf(`{"id":%s}`, id))
+				}
+			}
+			return http.StatusOK, "[" + strings.Join(tracks, ",") + "]"
+		},
+	}
+
+	ids := []int64{}
+	for id := int64(1); id <= 120; id++ {
+		ids = append(ids, id)
+	}
+	ids = append(ids, 3)
+
+	res, err := c.GetTracksByIds(context.Background(), ids)
+	assert.NoError(t, err)
+
+	want, missing := []int64{}, []int64{}
+	for _, id := range ids {
+		if id == 7 || (id >= 51 && id <= 100) {
+			missing = append(missing, id)
+		} else {
+			want = append(want, id)
+		}
+	}
+	got := []int64{}
+	for _, track := range res.Tracks {
+		got = append(got, track.ID)
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, missing, res.Missing)
+	assert.Equal(t, 3, ft.requests["/tracks"])
+}
+
+func Test_User(t *testing.T) {
+	c, err := soundcloud.NewClient()
+	assert.NoError(t, err)
+
+	id := int64(98081145) // Martin Garrix - Animals
+	track, err := c.GetTrackById(context.Background(), id)
+	assert.NoError(t, err)
+
+	t.Run("get user by id", func(t *testing.T) {
+		user, err := c.GetUserById(context.Background(), track.User.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, track.User.ID, user.ID)
+		assert.NotEmpty(t, user.Username)
+	})
+
+	t.Run("get user tracks", func(t *testing.T) {
+		limit := 10
+		res, err := c.GetUserTracks(context.Background(), track.User.ID, soundcloud.WithLimit(limit))
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, limit)
+		assert.NotEmpty(t, res.NextHref)
+
+		for _, track := range res.Items {
+			assert.NotEmpty(t, track.Transcodings)
+		}
+	})
+
+	t.Run("get user albums", func(t *testing.T) {
+		res, err := c.GetUserAlbums(context.Background(), track.User.ID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Items)
+	})
+
+	t.Run("get user followers", func(t *testing.T) {
+		limit := 10
+		res, err := c.GetUserFollowers(context.Background(), track.User.ID, soundcloud.WithLimit(limit))
+		assert.NoError(t, err)
+		assert.Len(t, res.Items, limit)
+	})
+
+	t.Run("get user track likes", func(t *testing.T) {
+		res, err := c.GetUserTrackLikes(context.Background(), track.User.ID)
+		assert.NoError(t, err)
+
+		for _, like := range res.Items {
+			assert.False(t, like.CreatedAt.IsZero())
+			assert.NotZero(t, like.Track.ID)
+		}
+	})
+}
+
+func Test_Paginator(t *testing.T) {
+	c, _ := newFakeClient(t, map[string]string{
+		"/users/1/tracks?offset=0": `{"collection":[{"id":1},{"id":2}],"next_href":"https://api-v2.soundcloud.com/users/1/tracks?offset=2&limit=2"}`,
+		"/users/1/tracks?offset=2": `{"collection":[{"id":3},{"id":4}],"next_href":"https://api-v2.soundcloud.com/users/1/tracks?offset=4&limit=2"}`,
+		"/users/1/tracks?offset=4": `{"collection":[{"id":5}],"next_href":null}`,
+	})
+
+	t.Run("without options", func(t *testing.T) {
+		first, err := c.GetUserTracks(context.Background(), 1, soundcloud.WithLimit(2))
+		assert.NoError(t, err)
+
+		ids := []int64{}
+		for track, err := range soundcloud.NewPaginator(first).All(context.Background()) {
+			assert.NoError(t, err)
+			ids = append(ids, track.ID)
+		}
+		assert.Equal(t, []int64{1, 2, 3, 4, 5}, ids)
+	})
+
+	t.Run("with max items", func(t *testing.T) {
+		first, err := c.GetUserTracks(context.Background(), 1, soundcloud.WithLimit(2))
+		assert.NoError(t, err)
+
+		p := soundcloud.NewPaginator(first, soundcloud.WithMaxItems(3))
+		ids := []int64{}
+		for track, err := range p.All(context.Background()) {
+			assert.NoError(t, err)
+			ids = append(ids, track.ID)
+		}
+		assert.Equal(t, []int64{1, 2, 3}, ids)
+		assert.False(t, p.HasNext())
+	})
+
+	t.Run("with cancelled context", func(t *testing.T) {
+		first, err := c.GetUserTracks(context.Background(), 1, soundcloud.WithLimit(2))
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err = soundcloud.NewPaginator(first).Next(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func Test_WriteSubtitles(t *testing.T) {
+	track := soundcloud.Track{
+		Title:    "Animals",
+		Duration: 10 * time.Second,
+		User:     soundcloud.User{Username: "Martin Garrix"},
+	}
+	comments := []soundcloud.Comment{
+		{Body: "drop", Timestamp: 9 * time.Second, User: soundcloud.User{Username: "c"}},
+		{Body: "hello", Timestamp: 1 * time.Second, User: soundcloud.User{Username: "a"}},
+		{Body: "hi <3", Timestamp: 2 * time.Second, User: soundcloud.User{Username: "b"}},
+	}
+
+	t.Run("webvtt", func(t *testing.T) {
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, track, comments, soundcloud.WEBVTT)
+		assert.NoError(t, err)
+		assert.Equal(t, "WEBVTT - Animals\n\n"+
+			"00:00:01.000 --> 00:00:05.000\na: hello\nb: hi &lt;3\n\n"+
+			"00:00:09.000 --> 00:00:10.000\nc: drop\n\n", sb.String())
+	})
+
+	t.Run("srt without merging", func(t *testing.T) {
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, track, comments, soundcloud.SRT, soundcloud.WithCollisionMerging(false), soundcloud.WithDisplayDuration(time.Second))
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n00:00:01,000 --> 00:00:02,000\na: hello\n\n"+
+			"2\n00:00:02,000 --> 00:00:03,000\nb: hi <3\n\n"+
+			"3\n00:00:09,000 --> 00:00:10,000\nc: drop\n\n", sb.String())
+	})
+
+	t.Run("lrc", func(t *testing.T) {
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, track, comments, soundcloud.LRC)
+		assert.NoError(t, err)
+		assert.Equal(t, "[ti:Animals]\n[ar:Martin Garrix]\n[length:00:10]\n"+
+			"[00:01.00]a: hello / b: hi <3\n"+
+			"[00:09.00]c: drop\n", sb.String())
+	})
+
+	t.Run("lrc with whitespace", func(t *testing.T) {
+		track := soundcloud.Track{Title: "Animals\n(Remix)", User: soundcloud.User{Username: " Martin\nGarrix "}}
+		comments := []soundcloud.Comment{
+			{Body: "   ", Timestamp: time.Second},
+			{Body: "drop\nnow", Timestamp: 2 * time.Second},
+		}
+
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, track, comments, soundcloud.LRC)
+		assert.NoError(t, err)
+		assert.Equal(t, "[ti:Animals (Remix)]\n[ar:Martin Garrix]\n"+
+			"[00:02.00]drop now\n", sb.String())
+	})
+
+	t.Run("srt with dense comments", func(t *testing.T) {
+		track := soundcloud.Track{Duration: time.Minute}
+		comments := []soundcloud.Comment{}
+		for i := 0; i < 10; i++ {
+			comments = append(comments, soundcloud.Comment{Body: fmt.Sprint(i), Timestamp: time.Duration(2*i) * time.Second})
+		}
+
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, track, comments, soundcloud.SRT)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n00:00:00,000 --> 00:00:05,000\n0\n1\n\n"+
+			"2\n00:00:04,000 --> 00:00:09,000\n2\n3\n\n"+
+			"3\n00:00:08,000 --> 00:00:13,000\n4\n5\n\n"+
+			"4\n00:00:12,000 --> 00:00:17,000\n6\n7\n\n"+
+			"5\n00:00:16,000 --> 00:00:21,000\n8\n9\n\n", sb.String())
+	})
+
+	t.Run("srt with burst of comments", func(t *testing.T) {
+		comments := []soundcloud.Comment{}
+		for i := 0; i < 5; i++ {
+			comments = append(comments, soundcloud.Comment{Body: fmt.Sprint(i), Timestamp: time.Duration(i) * 100 * time.Millisecond})
+		}
+
+		sb := &strings.Builder{}
+		err := soundcloud.WriteSubtitles(sb, soundcloud.Track{}, comments, soundcloud.SRT)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n00:00:00,000 --> 00:00:03,200\n0\n1\n2\n\n"+
+			"2\n00:00:00,300 --> 00:00:03,400\n3\n4\n\n", sb.String())
+	})
+}
+
+func Test_JSON(t *testing.T) {
+	track := soundcloud.Track{
+		ID:       1,
+		URN:      soundcloud.NewURN(soundcloud.URNTrack, 1),
+		Title:    "Test",
+		Duration: 3 * time.Minute,
+		Tags:     []string{"big room", "house"},
+		Transcodings: []soundcloud.Transcoding{
+			{
+				URL:      "https://api-v2.soundcloud.com/media/soundcloud:tracks:1/abc/stream/progressive",
+				Preset:   "mp3_1_0",
+				Duration: 3 * time.Minute,
+				Format: soundcloud.TranscodingFormat{
+					Protocol: "progressive",
+					MimeType: "audio/mpeg",
+				},
+				Quality: "sq",
+			},
+		},
+		User: soundcloud.User{
+			ID:       2,
+			Username: "test",
+			Badges:   soundcloud.UserBadges{Verified: true},
+			Visuals:  []soundcloud.Visual{{URN: soundcloud.URN{Kind: "visuals", ID: "1"}, URL: "https://i1.sndcdn.com/visuals-1.jpg"}},
+		},
+		CreatedAt: time.Date(2015, 6, 17, 10, 0, 0, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(track)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"format":{"protocol":"progressive","mime_type":"audio/mpeg"}`)
+	assert.Contains(t, string(data), `"user":{"id":2,`)
+	assert.Contains(t, string(data), `"duration":180000`)
+	assert.NotContains(t, string(data), `"duration":180000000000`)
+
+	rehydrated := soundcloud.Track{}
+	err = json.Unmarshal(data, &rehydrated)
+	assert.NoError(t, err)
+	assert.Equal(t, track, rehydrated)
+
+	t.Run("comment and playlist", func(t *testing.T) {
+		comment := soundcloud.Comment{ID: 1, Body: "drop", Timestamp: 61500 * time.Millisecond}
+		data, err := json.Marshal(comment)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"timestamp":61500`)
+
+		rehydratedComment := soundcloud.Comment{}
+		assert.NoError(t, json.Unmarshal(data, &rehydratedComment))
+		assert.Equal(t, comment, rehydratedComment)
+
+		playlist := soundcloud.Playlist{ID: 1, Duration: 90 * time.Second, Tracks: []soundcloud.Track{track}}
+		data, err = json.Marshal(playlist)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `"duration":90000`)
+
+		rehydratedPlaylist := soundcloud.Playlist{}
+		assert.NoError(t, json.Unmarshal(data, &rehydratedPlaylist))
+		assert.Equal(t, playlist, rehydratedPlaylist)
+	})
+
+	c, _ := newFakeClient(t, map[string]string{
+		"/media/soundcloud:tracks:1/abc/stream/progressive?offset=": `{"url":"https://cf-media.sndcdn.com/abc.mp3"}`,
+	})
+
+	stream, err := c.GetStream(context.Background(), rehydrated.Transcodings[0])
+	assert.NoError(t, err)
+	defer stream.Close()
+
+	audio, err := io.ReadAll(stream)
+	assert.NoError(t, err)
+	assert.Equal(t, "audio", string(audio))
+}
+
+func Test_RawResponses(t *testing.T) {
+	extra := struct {
+		Title string `json:"title"`
+	}{}
+
+	t.Run("without raw responses", func(t *testing.T) {
+		c, _ := newFakeClient(t, nil)
+
+		track, err := c.GetTrackById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Nil(t, track.Raw)
+		assert.ErrorIs(t, soundcloud.DecodeRaw(track.Raw, &extra), soundcloud.ErrNoRawResponse)
+	})
+
+	t.Run("without raw responses in suggestions", func(t *testing.T) {
+		c, _ := newFakeClient(t, map[string]string{
+			"/search/queries?offset=0": `{"collection":[{"output":"test"},{"kind":"track","id":1,"title":"Test"},{"kind":"user","id":2,"username":"test"}],"next_href":null}`,
+		})
+
+		res, err := c.SuggestQueries(context.Background(), "te")
+		assert.NoError(t, err)
+		assert.Len(t, res.Tracks, 1)
+		assert.Len(t, res.Users, 1)
+		assert.Nil(t, res.Tracks[0].Raw)
+		assert.Nil(t, res.Users[0].Raw)
+	})
+
+	t.Run("with raw responses", func(t *testing.T) {
+		c, _ := newFakeClient(t, nil, soundcloud.WithRawResponses())
+
+		track, err := c.GetTrackById(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":1,"kind":"track","title":"Test"}`, string(track.Raw))
+		assert.NoError(t, soundcloud.DecodeRaw(track.Raw, &extra))
+		assert.Equal(t, "Test", extra.Title)
+	})
+
+	t.Run("with raw responses in pages", func(t *testing.T) {
+		c, _ := newFakeClient(t, map[string]string{
+			"/users/1/tracks?offset=0": `{"collection":[{"id":1,"user":{"id":2,"username":"test"}}],"next_href":null}`,
+		}, soundcloud.WithRawResponses())
+
+		page, err := c.GetUserTracks(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, page.Raw)
+		assert.JSONEq(t, `{"id":2,"username":"test"}`, string(page.Items[0].User.Raw))
+	})
+}
+
+func Test_Attribution(t *testing.T) {
+	track := soundcloud.Track{
+		Title:        "Test",
+		PermalinkURL: "https://soundcloud.com/test/test",
+		User:         soundcloud.User{Username: "tester"},
+	}
+
+	t.Run("with cc license", func(t *testing.T) {
+		track.License = soundcloud.LicenseCCBYNCSA
+		s, err := track.Attribution()
+		assert.NoError(t, err)
+		assert.Equal(t, `"Test" by tester (https://soundcloud.com/test/test) is licensed under CC BY-NC-SA 3.0 (https://creativecommons.org/licenses/by-nc-sa/3.0/)`, s)
+	})
+
+	t.Run("with cc0 license", func(t *testing.T) {
+		track.License = soundcloud.LicenseNoRightsReserved
+		s, err := track.Attribution()
+		assert.NoError(t, err)
+		assert.Equal(t, `"Test" by tester (https://soundcloud.com/test/test) is marked with CC0 1.0 (https://creativecommons.org/publicdomain/zero/1.0/)`, s)
+	})
+
+	t.Run("with quotes in title", func(t *testing.T) {
+		track := track
+		track.Title = `Say "Hi"`
+		track.License = soundcloud.LicenseCCBY
+		s, err := track.Attribution()
+		assert.NoError(t, err)
+		assert.Equal(t, `"Say "Hi"" by tester (https://soundcloud.com/test/test) is licensed under CC BY 3.0 (https://creativecommons.org/licenses/by/3.0/)`, s)
+	})
+
+	t.Run("with all rights reserved", func(t *testing.T) {
+		track.License = soundcloud.LicenseAllRightsReserved
+		_, err := track.Attribution()
+		assert.ErrorIs(t, err, soundcloud.ErrNotCreativeCommons)
+	})
+}
+
+func Test_URN(t *testing.T) {
+	t.Run("parse", func(t *testing.T) {
+		urn, err := soundcloud.ParseURN("soundcloud:tracks:98081145")
+		assert.NoError(t, err)
+		assert.Equal(t, soundcloud.URNTrack, urn.Kind)
+		assert.Equal(t, "soundcloud:tracks:98081145", urn.String())
+
+		id, err := urn.Int64()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(98081145), id)
+		assert.Equal(t, urn, soundcloud.NewURN(soundcloud.URNTrack, id))
+	})
+
+	t.Run("parse invalid", func(t *testing.T) {
+		for _, s := range []string{"", "tracks:1", "spotify:tracks:1", "soundcloud::1", "soundcloud:tracks:", "soundcloud:tracks:1:2"} {
+			_, err := soundcloud.ParseURN(s)
+			assert.ErrorIs(t, err, soundcloud.ErrInvalidURN, s)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var v struct {
+			URN soundcloud.URN `json:"urn"`
+		}
+		err := json.Unmarshal([]byte(`{"urn":"soundcloud:users:1"}`), &v)
+		assert.NoError(t, err)
+		assert.Equal(t, soundcloud.NewURN(soundcloud.URNUser, 1), v.URN)
+
+		b, err := json.Marshal(v)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"urn":"soundcloud:users:1"}`, string(b))
+	})
+
+	t.Run("json with zero urn", func(t *testing.T) {
+		var v struct {
+			URN soundcloud.URN `json:"urn"`
+		}
+		b, err := json.Marshal(v)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"urn":""}`, string(b))
+
+		v.URN = soundcloud.NewURN(soundcloud.URNUser, 1)
+		err = json.Unmarshal(b, &v)
+		assert.NoError(t, err)
+		assert.True(t, v.URN.IsZero())
+	})
+
+	t.Run("lookup by urn", func(t *testing.T) {
+		c, _ := newFakeClient(t, map[string]string{
+			"/users/1/tracks?offset=0": `{"collection":[{"id":2,"urn":"soundcloud:tracks:2"}],"next_href":null}`,
+			"/tracks?offset=":          `[{"id":2,"urn":"soundcloud:tracks:2"}]`,
+		})
+
+		page, err := c.GetUserTracksByURN(context.Background(), soundcloud.NewURN(soundcloud.URNUser, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, soundcloud.NewURN(soundcloud.URNTrack, 2), page.Items[0].URN)
+
+		res, err := c.GetTracksByURNs(context.Background(), []soundcloud.URN{page.Items[0].URN, soundcloud.NewURN(soundcloud.URNTrack, 3)})
+		assert.NoError(t, err)
+		assert.Len(t, res.Tracks, 1)
+		assert.Equal(t, []int64{3}, res.Missing)
+	})
+
+	t.Run("kind mismatch", func(t *testing.T) {
+		c, err := soundcloud.NewClient(soundcloud.WithClientID("test"))
+		assert.NoError(t, err)
+
+		_, err = c.GetTrackByURN(context.Background(), soundcloud.NewURN(soundcloud.URNUser, 1))
+		assert.ErrorIs(t, err, soundcloud.ErrInvalidURN)
+	})
+}
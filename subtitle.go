@@ -0,0 +1,195 @@
+package soundcloud
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SubtitleFormat is a format timed comments can be exported to.
+type SubtitleFormat string
+
+const (
+	WEBVTT SubtitleFormat = "vtt"
+	SRT    SubtitleFormat = "srt"
+	LRC    SubtitleFormat = "lrc"
+)
+
+func (f SubtitleFormat) String() string {
+	return string(f)
+}
+
+type subtitleOptions struct {
+	displayDuration time.Duration
+	merge           bool
+}
+
+type SubtitleOption func(o *subtitleOptions)
+
+func defaultSubtitleOptions() *subtitleOptions {
+	return &subtitleOptions{
+		displayDuration: 3 * time.Second,
+		merge:           true,
+	}
+}
+
+// WithDisplayDuration sets how long each comment is shown for.
+func WithDisplayDuration(d time.Duration) SubtitleOption {
+	return func(o *subtitleOptions) {
+		o.displayDuration = d
+	}
+}
+
+// WithCollisionMerging merges comments that would be shown at the same time into a single cue.
+func WithCollisionMerging(merge bool) SubtitleOption {
+	return func(o *subtitleOptions) {
+		o.merge = merge
+	}
+}
+
+// maximum number of merged comments in a cue.
+const maxSubtitleCueLines = 3
+
+type subtitleCue struct {
+	start time.Duration
+	end   time.Duration
+	lines []string
+}
+
+// WriteSubtitles writes the timed comments of track to w in format.
+func WriteSubtitles(w io.Writer, track Track, comments []Comment, format SubtitleFormat, opts ...SubtitleOption) error {
+	options := defaultSubtitleOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.displayDuration <= 0 {
+		return fmt.Errorf("display duration must be positive")
+	}
+
+	cues := buildSubtitleCues(track, comments, options)
+
+	bw := bufio.NewWriter(w)
+	switch format {
+	case WEBVTT:
+		writeWebVTT(bw, track, cues)
+	case SRT:
+		writeSRT(bw, cues)
+	case LRC:
+		writeLRC(bw, track, cues)
+	default:
+		return fmt.Errorf("subtitle format not handled: %s", format)
+	}
+
+	return bw.Flush()
+}
+
+func buildSubtitleCues(track Track, comments []Comment, opts *subtitleOptions) []subtitleCue {
+	sorted := slices.Clone(comments)
+	slices.SortStableFunc(sorted, func(a, b Comment) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	duration := track.Duration
+
+	cues := make([]subtitleCue, 0, len(sorted))
+	for _, c := range sorted {
+		start := c.Timestamp
+		end := start + opts.displayDuration
+		if duration > 0 {
+			if start >= duration {
+				continue
+			}
+			end = min(end, duration)
+		}
+
+		line := normalizeSpace(c.Body)
+		if len(line) == 0 {
+			continue
+		}
+		if username := normalizeSpace(c.User.Username); len(username) > 0 {
+			line = fmt.Sprintf("%s: %s", username, line)
+		}
+
+		// comments are merged into a cue during its own display window only, so that merges do not chain.
+		if n := len(cues); opts.merge && n > 0 && start < cues[n-1].start+opts.displayDuration && len(cues[n-1].lines) < maxSubtitleCueLines {
+			cues[n-1].lines = append(cues[n-1].lines, line)
+			cues[n-1].end = max(cues[n-1].end, end)
+			continue
+		}
+
+		cues = append(cues, subtitleCue{
+			start: start,
+			end:   end,
+			lines: []string{line},
+		})
+	}
+
+	return cues
+}
+
+func writeWebVTT(w io.Writer, track Track, cues []subtitleCue) {
+	fmt.Fprint(w, "WEBVTT")
+	if len(track.Title) > 0 {
+		fmt.Fprintf(w, " - %s", normalizeSpace(track.Title))
+	}
+	fmt.Fprint(w, "\n\n")
+
+	escaper := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	for _, cue := range cues {
+		fmt.Fprintf(w, "%s --> %s\n", formatSubtitleTime(cue.start, "."), formatSubtitleTime(cue.end, "."))
+		for _, line := range cue.lines {
+			fmt.Fprintf(w, "%s\n", escaper.Replace(line))
+		}
+		fmt.Fprint(w, "\n")
+	}
+}
+
+func writeSRT(w io.Writer, cues []subtitleCue) {
+	for i, cue := range cues {
+		fmt.Fprintf(w, "%d\n", i+1)
+		fmt.Fprintf(w, "%s --> %s\n", formatSubtitleTime(cue.start, ","), formatSubtitleTime(cue.end, ","))
+		for _, line := range cue.lines {
+			fmt.Fprintf(w, "%s\n", line)
+		}
+		fmt.Fprint(w, "\n")
+	}
+}
+
+func writeLRC(w io.Writer, track Track, cues []subtitleCue) {
+	if title := normalizeSpace(track.Title); len(title) > 0 {
+		fmt.Fprintf(w, "[ti:%s]\n", title)
+	}
+	if artist := normalizeSpace(track.User.Username); len(artist) > 0 {
+		fmt.Fprintf(w, "[ar:%s]\n", artist)
+	}
+	if track.Duration > 0 {
+		fmt.Fprintf(w, "[length:%02d:%02d]\n", int(track.Duration.Minutes()), int(track.Duration.Seconds())%60)
+	}
+
+	// lrc lines have no end, so a cue is a single line shown until the next one.
+	for _, cue := range cues {
+		fmt.Fprintf(w, "%s%s\n", formatLRCTime(cue.start), strings.Join(cue.lines, " / "))
+	}
+}
+
+// normalizeSpace collapses runs of whitespace, including newlines, in s into single spaces.
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// formatSubtitleTime formats d as hh:mm:ss followed by sep and milliseconds.
+func formatSubtitleTime(d time.Duration, sep string) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
+
+// formatLRCTime formats d as [mm:ss.xx].
+func formatLRCTime(d time.Duration) string {
+	cs := d.Milliseconds() / 10
+	return fmt.Sprintf("[%02d:%02d.%02d]", cs/6000, cs/100%60, cs%100)
+}
@@ -0,0 +1,55 @@
+package soundcloud
+
+import "encoding/json"
+
+type QuerySuggestions struct {
+	// Queries ranked by relevance.
+	Queries []string
+	// Tracks and users matching the prefix directly.
+	Tracks []Track
+	Users  []User
+}
+
+type suggestionAPIResponse struct {
+	Output   string
+	Query    string
+	resource resourceAPIResponse
+}
+
+func (r *suggestionAPIResponse) UnmarshalJSON(data []byte) error {
+	v := &struct {
+		Output string `json:"output"`
+		Query  string `json:"query"`
+	}{}
+	err := json.Unmarshal(data, v)
+	if err != nil {
+		return err
+	}
+	r.Output = v.Output
+	r.Query = v.Query
+
+	return json.Unmarshal(data, &r.resource)
+}
+
+func toQuerySuggestions(r *collectionAPIResponse[suggestionAPIResponse], keepRaw bool) QuerySuggestions {
+	s := QuerySuggestions{
+		Queries: make([]string, 0),
+		Tracks:  make([]Track, 0),
+		Users:   make([]User, 0),
+	}
+
+	for _, item := range r.Collection {
+		switch {
+		case item.resource.Track != nil:
+			s.Tracks = append(s.Tracks, item.resource.Track.toTrack(keepRaw))
+		case item.resource.User != nil:
+			s.Users = append(s.Users, item.resource.User.toUser(keepRaw))
+		case len(item.Output) > 0:
+			s.Queries = append(s.Queries, item.Output)
+		case len(item.Query) > 0:
+			s.Queries = append(s.Queries, item.Query)
+		}
+	}
+
+	return s
+}
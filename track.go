@@ -1,52 +1,127 @@
 package soundcloud
 
-import "time"
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
 
 type Track struct {
-	ID                 int
-	Title              string
-	Description        string
-	ArtworkURL         string
-	Duration           int
-	Genre              string
-	CommentCount       int
-	LikesCount         int
-	TrackAuthorization string
-	Transcodings       []Transcoding
-	User               User
-	Kind               string
+	ID                 int64              `json:"id"`
+	URN                URN                `json:"urn"`
+	Title              string             `json:"title"`
+	Description        string             `json:"description"`
+	ArtworkURL         string             `json:"artwork_url"`
+	WaveformURL        string             `json:"waveform_url"`
+	Permalink          string             `json:"permalink"`
+	PermalinkURL       string             `json:"permalink_url"`
+	Duration           time.Duration      `json:"duration"`
+	FullDuration       time.Duration      `json:"full_duration"`
+	Genre              string             `json:"genre"`
+	Tags               []string           `json:"tags"`
+	LabelName          string             `json:"label_name"`
+	License            License            `json:"license"`
+	CommentCount       int                `json:"comment_count"`
+	LikesCount         int                `json:"likes_count"`
+	PlaybackCount      int                `json:"playback_count"`
+	RepostsCount       int                `json:"reposts_count"`
+	DownloadCount      int                `json:"download_count"`
+	Public             bool               `json:"public"`
+	Sharing            string             `json:"sharing"`
+	Streamable         bool               `json:"streamable"`
+	Downloadable       bool               `json:"downloadable"`
+	Policy             string             `json:"policy"`
+	PublisherMetadata  *PublisherMetadata `json:"publisher_metadata,omitempty"`
+	TrackAuthorization string             `json:"track_authorization"`
+	Transcodings       []Transcoding      `json:"transcodings"`
+	User               User               `json:"user"`
+	ReleaseDate        time.Time          `json:"release_date"`
+	CreatedAt          time.Time          `json:"created_at"`
+	LastModified       time.Time          `json:"last_modified"`
+	Kind               string             `json:"kind"`
+	// Raw is the api payload of the track, see WithRawResponses.
+	Raw json.RawMessage `json:"raw,omitempty"`
+}
+
+// MarshalJSON encodes durations in milliseconds, like the api does.
+func (t Track) MarshalJSON() ([]byte, error) {
+	type alias Track
+	return json.Marshal(struct {
+		alias
+		Duration     int64 `json:"duration"`
+		FullDuration int64 `json:"full_duration"`
+	}{alias(t), t.Duration.Milliseconds(), t.FullDuration.Milliseconds()})
+}
+
+func (t *Track) UnmarshalJSON(data []byte) error {
+	type alias Track
+	v := struct {
+		*alias
+		Duration     int64 `json:"duration"`
+		FullDuration int64 `json:"full_duration"`
+	}{alias: (*alias)(t)}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+	t.Duration = time.Duration(v.Duration) * time.Millisecond
+	t.FullDuration = time.Duration(v.FullDuration) * time.Millisecond
+	return nil
 }
 
 type trackAPIResponse struct {
-	ArtworkURL    string    `json:"artwork_url"`
-	CommentCount  int       `json:"comment_count"`
-	CreatedAt     time.Time `json:"created_at"`
-	Description   string    `json:"description"`
-	Duration      int       `json:"duration"`
-	Genre         string    `json:"genre"`
-	ID            int       `json:"id"`
-	Kind          string    `json:"kind"`
-	LabelName     string    `json:"label_name"`
-	LikesCount    int       `json:"likes_count"`
-	Permalink     string    `json:"permalink"`
-	PermalinkURL  string    `json:"permalink_url"`
-	PlaybackCount int       `json:"playback_count"`
-	Public        bool      `json:"public"`
-	RepostsCount  int       `json:"reposts_count"`
-	Sharing       string    `json:"sharing"`
-	Title         string    `json:"title"`
-	URI           string    `json:"uri"`
-	Urn           string    `json:"urn"`
-	UserID        int       `json:"user_id"`
-	WaveformURL   string    `json:"waveform_url"`
-	Media         struct {
+	ArtworkURL        string                        `json:"artwork_url"`
+	CommentCount      int                           `json:"comment_count"`
+	CreatedAt         time.Time                     `json:"created_at"`
+	Description       string                        `json:"description"`
+	DisplayDate       time.Time                     `json:"display_date"`
+	DownloadCount     int                           `json:"download_count"`
+	Downloadable      bool                          `json:"downloadable"`
+	Duration          int64                         `json:"duration"`
+	FullDuration      int64                         `json:"full_duration"`
+	Genre             string                        `json:"genre"`
+	ID                int64                         `json:"id"`
+	Kind              string                        `json:"kind"`
+	LabelName         string                        `json:"label_name"`
+	LastModified      time.Time                     `json:"last_modified"`
+	License           string                        `json:"license"`
+	LikesCount        int                           `json:"likes_count"`
+	Permalink         string                        `json:"permalink"`
+	PermalinkURL      string                        `json:"permalink_url"`
+	PlaybackCount     int                           `json:"playback_count"`
+	Policy            string                        `json:"policy"`
+	PublisherMetadata *publisherMetadataAPIResponse `json:"publisher_metadata"`
+	Public            bool                          `json:"public"`
+	ReleaseDate       string                        `json:"release_date"`
+	RepostsCount      int                           `json:"reposts_count"`
+	Sharing           string                        `json:"sharing"`
+	Streamable        bool                          `json:"streamable"`
+	TagList           string                        `json:"tag_list"`
+	Title             string                        `json:"title"`
+	URI               string                        `json:"uri"`
+	Urn               string                        `json:"urn"`
+	UserID            int64                         `json:"user_id"`
+	WaveformURL       string                        `json:"waveform_url"`
+	Media             struct {
 		Transcodings []transcodingAPIResponse `json:"transcodings"`
 	} `json:"media"`
 	TrackAuthorization string          `json:"track_authorization"`
 	User               userAPIResponse `json:"user"`
+
+	Raw json.RawMessage `json:"-"`
+}
+
+func (r *trackAPIResponse) UnmarshalJSON(data []byte) error {
+	type alias trackAPIResponse
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
 }
 
-func (r *trackAPIResponse) toTrack() Track {
+func (r *trackAPIResponse) toTrack(keepRaw bool) Track {
 	transcodings := make([]Transcoding, 0)
 	for _, t := range r.Media.Transcodings {
 		transcodings = append(transcodings, t.toTranscoding())
@@ -54,16 +129,67 @@ func (r *trackAPIResponse) toTrack() Track {
 
 	return Track{
 		ID:                 r.ID,
+		URN:                parseAPIURN(r.Urn),
 		Title:              r.Title,
 		Description:        r.Description,
 		ArtworkURL:         r.ArtworkURL,
-		Duration:           r.Duration,
+		WaveformURL:        r.WaveformURL,
+		Permalink:          r.Permalink,
+		PermalinkURL:       r.PermalinkURL,
+		Duration:           time.Duration(r.Duration) * time.Millisecond,
+		FullDuration:       time.Duration(r.FullDuration) * time.Millisecond,
 		Genre:              r.Genre,
+		Tags:               parseTagList(r.TagList),
+		LabelName:          r.LabelName,
+		License:            License(r.License),
 		CommentCount:       r.CommentCount,
 		LikesCount:         r.LikesCount,
+		PlaybackCount:      r.PlaybackCount,
+		RepostsCount:       r.RepostsCount,
+		DownloadCount:      r.DownloadCount,
+		Public:             r.Public,
+		Sharing:            r.Sharing,
+		Streamable:         r.Streamable,
+		Downloadable:       r.Downloadable,
+		Policy:             r.Policy,
+		PublisherMetadata:  r.PublisherMetadata.toPublisherMetadata(),
 		TrackAuthorization: r.TrackAuthorization,
 		Transcodings:       transcodings,
-		User:               r.User.toUser(),
+		User:               r.User.toUser(keepRaw),
+		ReleaseDate:        parseReleaseDate(r.ReleaseDate),
+		CreatedAt:          r.CreatedAt,
+		LastModified:       r.LastModified,
 		Kind:               r.Kind,
+		Raw:                rawPayload(keepRaw, r.Raw),
+	}
+}
+
+// isStub reports whether only the id of the track was returned.
+func (r *trackAPIResponse) isStub() bool {
+	return len(r.Title) == 0 && len(r.Media.Transcodings) == 0
+}
+
+// parseTagList splits a tag list on spaces, keeping double quoted tags such as "big room" whole.
+func parseTagList(s string) []string {
+	tags := make([]string, 0)
+	for i, part := range strings.Split(s, `"`) {
+		// odd parts are between quotes.
+		if i%2 == 1 {
+			if tag := strings.TrimSpace(part); len(tag) > 0 {
+				tags = append(tags, tag)
+			}
+			continue
+		}
+		tags = append(tags, strings.Fields(part)...)
+	}
+	return tags
+}
+
+// parseReleaseDate parses release dates, which are either a timestamp, a date or empty.
+func parseReleaseDate(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t, _ = time.Parse(time.DateOnly, s)
 	}
+	return t
 }
@@ -0,0 +1,8 @@
+package soundcloud
+
+type TracksByIdsResults struct {
+	// Tracks found, in the order of the requested ids.
+	Tracks []Track
+	// Missing ids that were not found or are not accessible.
+	Missing []int64
+}
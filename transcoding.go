@@ -1,22 +1,52 @@
 package soundcloud
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type Transcoding struct {
-	URL      string
-	Preset   string
-	Duration int
-	Snipped  bool
-	Format   struct {
-		Protocol string
-		MimeType string
+	URL                 string            `json:"url"`
+	Preset              string            `json:"preset"`
+	Duration            time.Duration     `json:"duration"`
+	Snipped             bool              `json:"snipped"`
+	Format              TranscodingFormat `json:"format"`
+	Quality             string            `json:"quality"`
+	IsLegacyTranscoding bool              `json:"is_legacy_transcoding"`
+}
+
+// MarshalJSON encodes the duration in milliseconds.
+func (t Transcoding) MarshalJSON() ([]byte, error) {
+	type alias Transcoding
+	return json.Marshal(struct {
+		alias
+		Duration int64 `json:"duration"`
+	}{alias(t), t.Duration.Milliseconds()})
+}
+
+func (t *Transcoding) UnmarshalJSON(data []byte) error {
+	type alias Transcoding
+	v := struct {
+		*alias
+		Duration int64 `json:"duration"`
+	}{alias: (*alias)(t)}
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
 	}
-	Quality             string
-	IsLegacyTranscoding bool
+	t.Duration = time.Duration(v.Duration) * time.Millisecond
+	return nil
+}
+
+type TranscodingFormat struct {
+	Protocol string `json:"protocol"`
+	MimeType string `json:"mime_type"`
 }
 
 type transcodingAPIResponse struct {
 	URL      string `json:"url"`
 	Preset   string `json:"preset"`
-	Duration int    `json:"duration"`
+	Duration int64  `json:"duration"`
 	Snipped  bool   `json:"snipped"`
 	Format   struct {
 		Protocol string `json:"protocol"`
@@ -28,14 +58,11 @@ type transcodingAPIResponse struct {
 
 func (r *transcodingAPIResponse) toTranscoding() Transcoding {
 	return Transcoding{
-		URL:      r.URL,
-		Preset:   r.Preset,
-		Duration: r.Duration,
-		Snipped:  r.Snipped,
-		Format: struct {
-			Protocol string
-			MimeType string
-		}(r.Format),
+		URL:                 r.URL,
+		Preset:              r.Preset,
+		Duration:            time.Duration(r.Duration) * time.Millisecond,
+		Snipped:             r.Snipped,
+		Format:              TranscodingFormat(r.Format),
 		Quality:             r.Quality,
 		IsLegacyTranscoding: r.IsLegacyTranscoding,
 	}
@@ -0,0 +1,117 @@
+package soundcloud
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidURN = errors.New("invalid urn")
+)
+
+// URNKind is the kind of resource a URN identifies.
+type URNKind string
+
+const (
+	URNTrack          URNKind = "tracks"
+	URNUser           URNKind = "users"
+	URNPlaylist       URNKind = "playlists"
+	URNComment        URNKind = "comments"
+	URNTrackStation   URNKind = "track-stations"
+	URNArtistStation  URNKind = "artist-stations"
+	URNSystemPlaylist URNKind = "system-playlists"
+	URNGenre          URNKind = "genres"
+	URNRegion         URNKind = "regions"
+)
+
+func (k URNKind) String() string {
+	return string(k)
+}
+
+const urnNamespace = "soundcloud"
+
+var urnKindRegexp = regexp.MustCompile(`^[a-z][a-z-]*$`)
+
+// URN identifies a Soundcloud resource, such as soundcloud:tracks:123.
+type URN struct {
+	Kind URNKind
+	ID   string
+}
+
+// NewURN returns the URN of the resource of kind with a numeric id.
+func NewURN(kind URNKind, id int64) URN {
+	return URN{
+		Kind: kind,
+		ID:   strconv.FormatInt(id, 10),
+	}
+}
+
+// ParseURN parses a URN of the form soundcloud:<kind>:<id>.
+func ParseURN(s string) (URN, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 3 || parts[0] != urnNamespace || !urnKindRegexp.MatchString(parts[1]) || len(parts[2]) == 0 {
+		return URN{}, fmt.Errorf("%w: %q", ErrInvalidURN, s)
+	}
+
+	return URN{
+		Kind: URNKind(parts[1]),
+		ID:   parts[2],
+	}, nil
+}
+
+func (u URN) String() string {
+	return fmt.Sprintf("%s:%s:%s", urnNamespace, u.Kind, u.ID)
+}
+
+// Int64 returns the id of the URN as a number, for kinds identified by one.
+func (u URN) Int64() (int64, error) {
+	id, err := strconv.ParseInt(u.ID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s does not have a numeric id", ErrInvalidURN, u)
+	}
+	return id, nil
+}
+
+// IsZero reports whether the URN is unset.
+func (u URN) IsZero() bool {
+	return u == URN{}
+}
+
+// idOf returns the numeric id of the URN, making sure it identifies a resource of one of kinds.
+func (u URN) idOf(kinds ...URNKind) (int64, error) {
+	if !slices.Contains(kinds, u.Kind) {
+		return 0, fmt.Errorf("%w: expected %s urn, got %q", ErrInvalidURN, kinds[0], u)
+	}
+	return u.Int64()
+}
+
+// MarshalText encodes the URN as text, which is empty for the zero URN.
+func (u URN) MarshalText() ([]byte, error) {
+	if u.IsZero() {
+		return []byte{}, nil
+	}
+	return []byte(u.String()), nil
+}
+
+func (u *URN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*u = URN{}
+		return nil
+	}
+	v, err := ParseURN(string(text))
+	if err != nil {
+		return err
+	}
+	*u = v
+	return nil
+}
+
+// parseAPIURN parses a URN returned by the api, which is zero when missing or malformed.
+func parseAPIURN(s string) URN {
+	u, _ := ParseURN(s)
+	return u
+}
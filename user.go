@@ -1,23 +1,54 @@
 package soundcloud
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type User struct {
-	ID                 int
-	Username           string
-	AvatarURL          string
-	Description        string
-	FirstName          string
-	LastName           string
-	FullName           string
-	FollowersCount     int
-	FollowingsCount    int
-	TrackCount         int
-	LikesCount         int
-	PlaylistLikesCount int
-	PermalinkURL       string
-	CreatedAt          time.Time
-	Kind               string
+	ID                 int64      `json:"id"`
+	URN                URN        `json:"urn"`
+	Username           string     `json:"username"`
+	Permalink          string     `json:"permalink"`
+	PermalinkURL       string     `json:"permalink_url"`
+	AvatarURL          string     `json:"avatar_url"`
+	Description        string     `json:"description"`
+	FirstName          string     `json:"first_name"`
+	LastName           string     `json:"last_name"`
+	FullName           string     `json:"full_name"`
+	City               string     `json:"city"`
+	CountryCode        string     `json:"country_code"`
+	Verified           bool       `json:"verified"`
+	Badges             UserBadges `json:"badges"`
+	Visuals            []Visual   `json:"visuals"`
+	FollowersCount     int        `json:"followers_count"`
+	FollowingsCount    int        `json:"followings_count"`
+	TrackCount         int        `json:"track_count"`
+	PlaylistCount      int        `json:"playlist_count"`
+	LikesCount         int        `json:"likes_count"`
+	PlaylistLikesCount int        `json:"playlist_likes_count"`
+	CommentsCount      int        `json:"comments_count"`
+	StationURN         URN        `json:"station_urn"`
+	StationPermalink   string     `json:"station_permalink"`
+	CreatedAt          time.Time  `json:"created_at"`
+	LastModified       time.Time  `json:"last_modified"`
+	Kind               string     `json:"kind"`
+	// Raw is the api payload of the user, see WithRawResponses.
+	Raw json.RawMessage `json:"raw,omitempty"`
+}
+
+type UserBadges struct {
+	Pro            bool `json:"pro"`
+	ProUnlimited   bool `json:"pro_unlimited"`
+	CreatorMidTier bool `json:"creator_mid_tier"`
+	Verified       bool `json:"verified"`
+}
+
+// Visual is a banner image shown on the user's profile.
+type Visual struct {
+	URN       URN    `json:"urn"`
+	URL       string `json:"url"`
+	EntryTime int    `json:"entry_time"`
 }
 
 type userAPIResponse struct {
@@ -42,7 +73,7 @@ type userAPIResponse struct {
 	FirstName          string    `json:"first_name"`
 	FullName           string    `json:"full_name"`
 	GroupsCount        int       `json:"groups_count"`
-	ID                 int       `json:"id"`
+	ID                 int64     `json:"id"`
 	Kind               string    `json:"kind"`
 	LastModified       time.Time `json:"last_modified"`
 	LastName           string    `json:"last_name"`
@@ -79,24 +110,63 @@ type userAPIResponse struct {
 		Year  int `json:"year"`
 		Day   int `json:"day"`
 	} `json:"date_of_birth"`
+
+	Raw json.RawMessage `json:"-"`
 }
 
-func (r *userAPIResponse) toUser() User {
+func (r *userAPIResponse) UnmarshalJSON(data []byte) error {
+	type alias userAPIResponse
+	err := json.Unmarshal(data, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Raw = cloneRaw(data)
+	return nil
+}
+
+func (r *userAPIResponse) toUser(keepRaw bool) User {
+	visuals := make([]Visual, 0)
+	for _, v := range r.Visuals.Visuals {
+		visuals = append(visuals, Visual{
+			URN:       parseAPIURN(v.Urn),
+			URL:       v.VisualURL,
+			EntryTime: v.EntryTime,
+		})
+	}
+
 	return User{
-		ID:                 r.ID,
-		Username:           r.Username,
-		AvatarURL:          r.AvatarURL,
-		Description:        r.Description,
-		FirstName:          r.FirstName,
-		LastName:           r.LastName,
-		FullName:           r.FullName,
+		ID:           r.ID,
+		URN:          parseAPIURN(r.Urn),
+		Username:     r.Username,
+		Permalink:    r.Permalink,
+		PermalinkURL: r.PermalinkURL,
+		AvatarURL:    r.AvatarURL,
+		Description:  r.Description,
+		FirstName:    r.FirstName,
+		LastName:     r.LastName,
+		FullName:     r.FullName,
+		City:         r.City,
+		CountryCode:  r.CountryCode,
+		Verified:     r.Verified,
+		Badges: UserBadges{
+			Pro:            r.Badges.Pro,
+			ProUnlimited:   r.Badges.ProUnlimited,
+			CreatorMidTier: r.Badges.CreatorMidTier,
+			Verified:       r.Badges.Verified,
+		},
+		Visuals:            visuals,
 		FollowersCount:     r.FollowersCount,
 		FollowingsCount:    r.FollowingsCount,
 		TrackCount:         r.TrackCount,
+		PlaylistCount:      r.PlaylistCount,
 		LikesCount:         r.LikesCount,
-		PlaylistLikesCount: r.PlaylistCount,
-		PermalinkURL:       r.PermalinkURL,
+		PlaylistLikesCount: r.PlaylistLikesCount,
+		CommentsCount:      r.CommentsCount,
+		StationURN:         parseAPIURN(r.StationUrn),
+		StationPermalink:   r.StationPermalink,
 		CreatedAt:          r.CreatedAt,
+		LastModified:       r.LastModified,
 		Kind:               r.Kind,
+		Raw:                rawPayload(keepRaw, r.Raw),
 	}
 }
@@ -0,0 +1,69 @@
+package soundcloud
+
+import "strings"
+
+type Waveform struct {
+	Width   int   `json:"width"`
+	Height  int   `json:"height"`
+	Samples []int `json:"samples"`
+}
+
+type waveformAPIResponse struct {
+	Width   int   `json:"width"`
+	Height  int   `json:"height"`
+	Samples []int `json:"samples"`
+}
+
+func (r *waveformAPIResponse) toWaveform() Waveform {
+	return Waveform{
+		Width:   r.Width,
+		Height:  r.Height,
+		Samples: r.Samples,
+	}
+}
+
+// waveformJSONURL returns the url of the json waveform, which is also served for png waveform urls.
+func waveformJSONURL(url string) string {
+	return strings.TrimSuffix(url, ".png") + ".json"
+}
+
+// Resample returns the waveform with n samples, keeping the peak of each bucket.
+func (w Waveform) Resample(n int) Waveform {
+	samples := make([]int, 0, max(n, 0))
+	if len(w.Samples) > 0 {
+		for i := 0; i < n; i++ {
+			start := i * len(w.Samples) / n
+			end := max((i+1)*len(w.Samples)/n, start+1)
+
+			peak := 0
+			for _, s := range w.Samples[start:end] {
+				peak = max(peak, s)
+			}
+			samples = append(samples, peak)
+		}
+	}
+
+	return Waveform{
+		Width:   len(samples),
+		Height:  w.Height,
+		Samples: samples,
+	}
+}
+
+// Normalize returns the samples scaled between 0 and 1, relative to the loudest sample.
+func (w Waveform) Normalize() []float64 {
+	peak := 0
+	for _, s := range w.Samples {
+		peak = max(peak, s)
+	}
+
+	normalized := make([]float64, len(w.Samples))
+	if peak == 0 {
+		return normalized
+	}
+	for i, s := range w.Samples {
+		normalized[i] = float64(s) / float64(peak)
+	}
+
+	return normalized
+}
@@ -0,0 +1,199 @@
+package soundcloud
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"time"
+)
+
+type renderOptions struct {
+	width       int
+	height      int
+	background  color.Color
+	foreground  color.Color
+	played      color.Color
+	barWidth    int
+	barGap      int
+	progress    float64
+	markers     []time.Duration
+	duration    time.Duration
+	markerColor color.Color
+}
+
+type RenderOption func(o *renderOptions)
+
+func defaultRenderOptions() *renderOptions {
+	return &renderOptions{
+		width:       800,
+		height:      160,
+		background:  color.Transparent,
+		foreground:  color.RGBA{0xcc, 0xcc, 0xcc, 0xff},
+		played:      color.RGBA{0xff, 0x55, 0x00, 0xff},
+		barWidth:    2,
+		barGap:      1,
+		progress:    0,
+		markerColor: color.RGBA{0x33, 0x33, 0x33, 0xff},
+	}
+}
+
+// WithSize sets the size of the image in pixels.
+func WithSize(width, height int) RenderOption {
+	return func(o *renderOptions) {
+		o.width = width
+		o.height = height
+	}
+}
+
+// WithColors sets the colors of the background, of the bars and of the bars already played.
+func WithColors(background, foreground, played color.Color) RenderOption {
+	return func(o *renderOptions) {
+		o.background = background
+		o.foreground = foreground
+		o.played = played
+	}
+}
+
+// WithBars sets the width of the bars and of the gap between them in pixels.
+func WithBars(width, gap int) RenderOption {
+	return func(o *renderOptions) {
+		o.barWidth = width
+		o.barGap = gap
+	}
+}
+
+// WithProgress draws the bars before progress, between 0 and 1, in the played color.
+func WithProgress(progress float64) RenderOption {
+	return func(o *renderOptions) {
+		o.progress = progress
+	}
+}
+
+// WithCommentMarkers marks the position of timed comments on a track lasting duration.
+func WithCommentMarkers(comments []Comment, duration time.Duration, c color.Color) RenderOption {
+	return func(o *renderOptions) {
+		o.markers = make([]time.Duration, 0, len(comments))
+		for _, comment := range comments {
+			o.markers = append(o.markers, comment.Timestamp)
+		}
+		o.duration = duration
+		o.markerColor = c
+	}
+}
+
+type renderRect struct {
+	x, y, w, h int
+	c          color.Color
+}
+
+// RenderSVG draws the waveform as an svg image.
+func (w Waveform) RenderSVG(out io.Writer, opts ...RenderOption) error {
+	options, err := buildRenderOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, options.width, options.height, options.width, options.height)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range w.renderRects(options) {
+		fill, opacity := svgColor(r.c)
+		if opacity == 0 {
+			continue
+		}
+
+		_, err = fmt.Fprintf(out, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"`, r.x, r.y, r.w, r.h, fill)
+		if err != nil {
+			return err
+		}
+		if opacity < 1 {
+			_, err = fmt.Fprintf(out, ` fill-opacity="%.3f"`, opacity)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprint(out, `/>`)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(out, "</svg>\n")
+	return err
+}
+
+// RenderPNG draws the waveform as a png image.
+func (w Waveform) RenderPNG(out io.Writer, opts ...RenderOption) error {
+	options, err := buildRenderOptions(opts)
+	if err != nil {
+		return err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, options.width, options.height))
+	for _, r := range w.renderRects(options) {
+		draw.Draw(img, image.Rect(r.x, r.y, r.x+r.w, r.y+r.h), image.NewUniform(r.c), image.Point{}, draw.Over)
+	}
+
+	return png.Encode(out, img)
+}
+
+func buildRenderOptions(opts []RenderOption) (*renderOptions, error) {
+	options := defaultRenderOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.width <= 0 || options.height <= 0 {
+		return nil, fmt.Errorf("invalid size: %dx%d", options.width, options.height)
+	}
+	if options.barWidth <= 0 || options.barGap < 0 {
+		return nil, fmt.Errorf("invalid bars: width %d, gap %d", options.barWidth, options.barGap)
+	}
+
+	return options, nil
+}
+
+// renderRects lays out the background, bars and markers, bottom aligned, in drawing order.
+func (w Waveform) renderRects(o *renderOptions) []renderRect {
+	rects := []renderRect{{0, 0, o.width, o.height, o.background}}
+
+	step := o.barWidth + o.barGap
+	bars := (o.width + o.barGap) / step
+	playedX := int(math.Round(o.progress * float64(o.width)))
+
+	for i, v := range w.Resample(bars).Normalize() {
+		x := i * step
+		h := max(int(math.Round(v*float64(o.height))), 1)
+
+		c := o.foreground
+		if x+o.barWidth/2 < playedX {
+			c = o.played
+		}
+		rects = append(rects, renderRect{x, o.height - h, o.barWidth, h, c})
+	}
+
+	if o.duration > 0 {
+		size := max(o.height/10, 2)
+		for _, m := range o.markers {
+			if m < 0 || m > o.duration {
+				continue
+			}
+			x := int(float64(m) / float64(o.duration) * float64(o.width-size))
+			rects = append(rects, renderRect{x, o.height - size, size, size, o.markerColor})
+		}
+	}
+
+	return rects
+}
+
+// svgColor returns c as a hex color and an opacity.
+func svgColor(c color.Color) (string, float64) {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B), float64(n.A) / 0xff
+}